@@ -151,6 +151,8 @@ func Provider() *schema.Provider {
 			"argocd_repository_certificate": resourceArgoCDRepositoryCertificates(),
 			"argocd_cluster":                resourceArgoCDCluster(),
 			"argocd_project":                resourceArgoCDProject(),
+			"argocd_project_role":           resourceArgoCDProjectRole(),
+			"argocd_project_sync_window":    resourceArgoCDProjectSyncWindow(),
 			"argocd_project_token":          resourceArgoCDProjectToken(),
 			"argocd_repository":             resourceArgoCDRepository(),
 			"argocd_repository_credentials": resourceArgoCDRepositoryCredentials(),
@@ -3,12 +3,17 @@ package argocd
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
 	applicationClient "github.com/dcoppa/argo-cd/v2/pkg/apiclient/application"
+	clusterClient "github.com/dcoppa/argo-cd/v2/pkg/apiclient/cluster"
+	projectClient "github.com/dcoppa/argo-cd/v2/pkg/apiclient/project"
 	application "github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -24,8 +29,9 @@ func resourceArgoCDApplication() *schema.Resource {
 		ReadContext:   resourceArgoCDApplicationRead,
 		UpdateContext: resourceArgoCDApplicationUpdate,
 		DeleteContext: resourceArgoCDApplicationDelete,
+		CustomizeDiff: resourceArgoCDApplicationCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceArgoCDApplicationImportState,
 		},
 		Schema: map[string]*schema.Schema{
 			"metadata": metadataSchema("applications.argoproj.io"),
@@ -36,7 +42,208 @@ func resourceArgoCDApplication() *schema.Resource {
 				Optional:    true,
 				Default:     true,
 			},
+			"paused": {
+				Type:        schema.TypeBool,
+				Description: "Whether to pause the reconciliation of the application by ArgoCD (using the `argocd.argoproj.io/skip-reconcile` annotation), e.g. during maintenance windows. While paused, the application status is not updated and no sync is performed, hence `wait = true` would time out.",
+				Optional:    true,
+				Default:     false,
+			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Description: "Whether to prevent the application from being deleted by Terraform, e.g. to protect production applications against an accidental `terraform destroy`. The flag must be unset (and applied) before the application can be deleted.",
+				Optional:    true,
+				Default:     false,
+			},
+			"validate": {
+				Type:        schema.TypeBool,
+				Description: "Whether to validate the application spec before creating or updating the application. Disabling validation allows applications to be created while the source repository is not (yet) reachable by ArgoCD. When enabled, the application sources, destination and resources are also checked against the restrictions of an existing project at plan time.",
+				Optional:    true,
+				Default:     true,
+			},
+			"allow_appset_owned": {
+				Type:        schema.TypeBool,
+				Description: "Whether to allow managing an application owned by an ApplicationSet, as identified by its owner references. By default, creating or updating such an application fails, as any change made from Terraform would be reverted by the ApplicationSet controller. Importing such an application is not checked.",
+				Optional:    true,
+				Default:     false,
+			},
+			"deletion_finalizer": {
+				Type:         schema.TypeString,
+				Description:  "Finalizer controlling the deletion of the application resources when the application is deleted, regardless of how the deletion is triggered. One of `none`, `foreground` (`resources-finalizer.argocd.argoproj.io`) or `background` (`resources-finalizer.argocd.argoproj.io/background`). Defaults to the finalizer currently set on the application. Other finalizers set on the application are left untouched.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDeletionFinalizer,
+			},
+			"force_delete_after": {
+				Type:         schema.TypeString,
+				Description:  "When `cascade = true`, duration (e.g. `5m`) after which the finalizers of an application that is still being deleted are removed, orphaning any remaining resources, instead of failing once the delete timeout is reached. Must be shorter than the Terraform Delete resource timeout to have any effect.",
+				Optional:     true,
+				ValidateFunc: validateDuration,
+			},
+			"initial_sync": {
+				Type:        schema.TypeList,
+				Description: "Trigger a sync of the application right after it has been created, e.g. for applications without automated sync policy that must be deployed as part of the Terraform run. Changes to this block have no effect once the application has been created.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"revision": {
+							Type:        schema.TypeString,
+							Description: "Revision to sync the application to. Defaults to the target revision of the application.",
+							Optional:    true,
+						},
+						"prune": {
+							Type:        schema.TypeBool,
+							Description: "Whether to delete resources that are no longer defined in the sources.",
+							Optional:    true,
+						},
+						"force": {
+							Type:        schema.TypeBool,
+							Description: "Whether to use a force apply (i.e. delete and re-create resources when needed).",
+							Optional:    true,
+						},
+						"strategy": {
+							Type:         schema.TypeString,
+							Description:  "Sync strategy, one of `apply` (i.e. `kubectl apply`, skipping hooks) or `hook` (run hooks and apply).",
+							Optional:     true,
+							Default:      "hook",
+							ValidateFunc: validateSyncStrategy,
+						},
+						"sync_options": {
+							Type:        schema.TypeList,
+							Description: "Sync options to use for this sync only. More info: https://argo-cd.readthedocs.io/en/stable/user-guide/sync-options/.",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateSyncOption,
+							},
+						},
+					},
+				},
+			},
+			"fail_on_condition_types": {
+				Type:        schema.TypeList,
+				Description: "Application condition types (e.g. `InvalidSpecError`, `ComparisonError`) that make the creation or update of the application fail when reported by ArgoCD once it has reconciled the application, instead of silently succeeding. Conditions are checked after waiting for the application when `wait = true`.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateApplicationConditionType,
+				},
+			},
+			"refresh_on_read": {
+				Type:         schema.TypeString,
+				Description:  "Type of refresh to request from ArgoCD before reading the application, so that the reported sync and health status reflect the latest state of the source repository rather than the cached controller state. One of `none`, `normal` or `hard` (which also invalidates the manifest cache).",
+				Optional:     true,
+				Default:      "none",
+				ValidateFunc: validateRefreshType,
+			},
+			"drift_detection": {
+				Type:         schema.TypeString,
+				Description:  "Whether to compare the live application resources with the target state (using ArgoCD's server-side diff) and report the drifted resources. One of `none`, `warn` (raise a warning when reading the application) or `error` (fail the plan). Useful to detect clusters drifting from git when self-healing is disabled.",
+				Optional:     true,
+				Default:      "none",
+				ValidateFunc: validateDriftDetection,
+			},
+			"terminate_operation_on_timeout": {
+				Type:        schema.TypeBool,
+				Description: "Whether to terminate any in-flight operation (e.g. a sync blocked on a stuck hook) when waiting for the application times out, and before deleting the application, so that subsequent applies or the deletion are not refused by ArgoCD.",
+				Optional:    true,
+				Default:     false,
+			},
+			"wait_for_applications": {
+				Type:        schema.TypeList,
+				Description: "IDs (`<name>:<namespace>` or `<namespace>/<name>`) of other applications that must be healthy before this application is created or updated, e.g. to install an application deploying CRDs before the applications relying on them. The wait is bounded by the Terraform Create and Update resource timeouts.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateApplicationReference,
+				},
+			},
+			"wait": {
+				Type:        schema.TypeBool,
+				Description: "Upon application creation or update, wait for application health/sync status to be healthy/Synced, upon application deletion, wait for application to be removed, when set to true. Wait timeouts are controlled by Terraform Create, Update and Delete resource timeouts (all default to 5 minutes). **Note**: if ArgoCD decides not to sync an application (e.g. because the project to which the application belongs has a `sync_window` applied) then you will experience an expected timeout event if `wait = true`.",
+				Optional:    true,
+				Default:     false,
+			},
+			"wait_for": {
+				Type:         schema.TypeList,
+				Description:  "Only wait for the application resources matching these conditions to reach the expected health status, instead of waiting for the whole application to be healthy and synced. Useful for applications that intentionally contain resources that never become healthy. Only applies when `wait = true`.",
+				Optional:     true,
+				RequiredWith: []string{"wait"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group": {
+							Type:        schema.TypeString,
+							Description: "The Kubernetes resource Group. Defaults to matching any group.",
+							Optional:    true,
+						},
+						"kind": {
+							Type:        schema.TypeString,
+							Description: "The Kubernetes resource Kind.",
+							Required:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The Kubernetes resource Name. Defaults to matching all resources of the given kind.",
+							Optional:    true,
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Description: "The Kubernetes resource Namespace. Defaults to matching any namespace.",
+							Optional:    true,
+						},
+						"health": {
+							Type:         schema.TypeString,
+							Description:  "Expected health status of the matching resources.",
+							Optional:     true,
+							Default:      string(health.HealthStatusHealthy),
+							ValidateFunc: validateHealthStatus,
+						},
+					},
+				},
+			},
 			"status": applicationStatusSchema(),
+			"health_status": {
+				Type:        schema.TypeString,
+				Description: "Application's current health status (e.g. `Healthy`, `Progressing`, `Degraded`). Shorthand for `status.0.health.0.status`.",
+				Computed:    true,
+			},
+			"sync_status": {
+				Type:        schema.TypeString,
+				Description: "Application's current sync status (e.g. `Synced`, `OutOfSync`). Shorthand for `status.0.sync.0.status`.",
+				Computed:    true,
+			},
+			"sync_revision": {
+				Type:        schema.TypeString,
+				Description: "Revision the application was last compared against. Shorthand for `status.0.sync.0.revision`.",
+				Computed:    true,
+			},
+			"last_operation_phase": {
+				Type:        schema.TypeString,
+				Description: "Phase of the last operation (e.g. `Succeeded`, `Failed`, `Running`). Shorthand for `status.0.operation_state.0.phase`.",
+				Computed:    true,
+			},
+			"last_operation_message": {
+				Type:        schema.TypeString,
+				Description: "Message of the last operation. Shorthand for `status.0.operation_state.0.message`.",
+				Computed:    true,
+			},
+			"last_synced_revision": {
+				Type:        schema.TypeString,
+				Description: "Revision deployed by the last sync operation. Shorthand for `status.0.operation_state.0.revision`.",
+				Computed:    true,
+			},
+			"images": {
+				Type:        schema.TypeList,
+				Description: "All container images used by the application's child resources. Shorthand for `status.0.summary.0.images`.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"external_urls": {
+				Type:        schema.TypeList,
+				Description: "All external URLs of the application's child resources. Shorthand for `status.0.summary.0.external_urls`.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 		SchemaVersion: 4,
 		StateUpgraders: []schema.StateUpgrader{
@@ -69,6 +276,385 @@ func resourceArgoCDApplication() *schema.Resource {
 	}
 }
 
+func resourceArgoCDApplicationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateApplicationSourceRefs(d); err != nil {
+		return err
+	}
+
+	if err := validateApplicationHelmFileParameters(d); err != nil {
+		return err
+	}
+
+	if err := validateApplicationProjectRestrictions(ctx, d, meta); err != nil {
+		return err
+	}
+
+	// Drift is checked at plan time rather than upon read when it must fail,
+	// so that drifted applications can still be refreshed and destroyed.
+	if d.Id() == "" || d.Get("drift_detection").(string) != "error" {
+		return nil
+	}
+
+	si := meta.(*provider.ServerInterface)
+	if diags := si.InitClients(ctx); diags != nil {
+		return fmt.Errorf("failed to initialize clients: %s", diags[0].Detail())
+	}
+
+	ids := strings.Split(d.Id(), ":")
+
+	drifted, err := applicationDriftedResources(ctx, si, ids[0], ids[1])
+	if err != nil {
+		return fmt.Errorf("failed to diff application %s: %w", ids[0], err)
+	}
+
+	if len(drifted) > 0 {
+		return fmt.Errorf("application %s has drifted from its target state, the following resources differ from the target revision:\n  - %s", ids[0], strings.Join(drifted, "\n  - "))
+	}
+
+	return nil
+}
+
+// applicationSyncPolicyAutomatedWarnings warns when `allow_empty` is enabled
+// without `prune`, as automated syncs can then never delete all the
+// application resources and the setting has no effect.
+func applicationSyncPolicyAutomatedWarnings(spec application.ApplicationSpec) diag.Diagnostics {
+	if spec.SyncPolicy == nil || spec.SyncPolicy.Automated == nil {
+		return nil
+	}
+
+	if a := spec.SyncPolicy.Automated; a.AllowEmpty && !a.Prune {
+		return []diag.Diagnostic{
+			{
+				Severity: diag.Warning,
+				Summary:  "spec.0.sync_policy.0.automated: allow_empty has no effect unless prune is enabled",
+			},
+		}
+	}
+
+	return nil
+}
+
+// validateApplicationProjectRestrictions ensures that the application sources,
+// destination and resources are permitted by its project, so that
+// applications which would be rejected by ArgoCD, or fail to sync, fail at
+// plan time rather than upon apply. The check is skipped when validation is
+// disabled, when any of the relevant attributes is unknown, or when the
+// project does not exist yet (e.g. it is created within the same apply).
+// Managed resources are only known for existing applications, while a
+// namespace created through the `CreateNamespace=true` sync option is always
+// checked.
+func validateApplicationProjectRestrictions(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("validate").(bool) {
+		return nil
+	}
+
+	for _, k := range []string{"spec.0.project", "spec.0.source", "spec.0.destination", "spec.0.sync_policy"} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	if d.Id() != "" && !d.HasChanges("spec.0.project", "spec.0.source", "spec.0.destination", "spec.0.sync_policy") {
+		return nil
+	}
+
+	if d.Get("spec.0.destination").(*schema.Set).Len() == 0 {
+		return nil
+	}
+
+	spec, err := expandApplicationSpec(d.Get("spec.0").(map[string]interface{}))
+	if err != nil {
+		// Reported upon apply
+		return nil
+	}
+
+	si := meta.(*provider.ServerInterface)
+	if diags := si.InitClients(ctx); diags != nil {
+		return fmt.Errorf("failed to initialize clients: %s", diags[0].Detail())
+	}
+
+	p, err := si.ProjectClient.Get(ctx, &projectClient.ProjectQuery{
+		Name: spec.Project,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get project %s: %w", spec.Project, err)
+	}
+
+	var existing *application.Application
+
+	if d.Id() != "" {
+		ids := strings.Split(d.Id(), ":")
+
+		existing, err = getApplication(ctx, si, ids[0], ids[1])
+		if err != nil {
+			return fmt.Errorf("failed to get application %s: %w", ids[0], err)
+		}
+	}
+
+	for _, src := range spec.GetSources() {
+		if !p.IsSourcePermitted(src) {
+			return fmt.Errorf("application will be rejected: repository %s is not permitted in project %s", src.RepoURL, spec.Project)
+		}
+	}
+
+	dst := spec.Destination
+
+	// Destinations may be referenced by either cluster name or server URL,
+	// while project destinations may be restricted on any of them.
+	if c, err := si.ClusterClient.Get(ctx, &clusterClient.ClusterQuery{Server: dst.Server, Name: dst.Name}); err == nil {
+		dst.Name, dst.Server = c.Name, c.Server
+	}
+
+	permitted, err := p.IsDestinationPermitted(dst, func(project string) ([]*application.Cluster, error) {
+		cl, err := si.ClusterClient.List(ctx, &clusterClient.ClusterQuery{})
+		if err != nil {
+			return nil, err
+		}
+
+		clusters := make([]*application.Cluster, 0)
+
+		for i := range cl.Items {
+			if cl.Items[i].Project == project {
+				clusters = append(clusters, &cl.Items[i])
+			}
+		}
+
+		return clusters, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to validate destination against project %s: %w", spec.Project, err)
+	}
+
+	if !permitted {
+		return fmt.Errorf("application will be rejected: destination server %q, name %q and namespace %q do not match any of the allowed destinations in project %s", dst.Server, dst.Name, dst.Namespace, spec.Project)
+	}
+
+	if spec.SyncPolicy != nil && spec.SyncPolicy.SyncOptions.HasOption("CreateNamespace=true") && !p.IsGroupKindPermitted(k8sschema.GroupKind{Kind: "Namespace"}, false) {
+		return fmt.Errorf("application will fail to sync: cluster resource Namespace is not permitted in project %s, which is required by the CreateNamespace=true sync option", spec.Project)
+	}
+
+	if existing == nil {
+		return nil
+	}
+
+	for _, r := range existing.Status.Resources {
+		namespaced := r.Namespace != ""
+
+		if p.IsGroupKindPermitted(k8sschema.GroupKind{Group: r.Group, Kind: r.Kind}, namespaced) {
+			continue
+		}
+
+		scope := "cluster"
+		if namespaced {
+			scope = "namespace"
+		}
+
+		return fmt.Errorf("application will fail to sync: %s resource %s/%s %s is not permitted in project %s", scope, r.Group, r.Kind, r.Name, spec.Project)
+	}
+
+	return nil
+}
+
+// validateApplicationSourceRefs ensures that all `$ref` prefixed Helm value
+// files reference a source declaring the matching `ref`.
+func validateApplicationSourceRefs(d *schema.ResourceDiff) error {
+	sources, ok := d.Get("spec.0.source").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	refs := make(map[string]bool)
+
+	for i, _s := range sources {
+		if !d.NewValueKnown(fmt.Sprintf("spec.0.source.%d.ref", i)) {
+			// Refs are not known yet, nothing can be validated
+			return nil
+		}
+
+		if s, ok := _s.(map[string]interface{}); ok {
+			if ref, ok := s["ref"].(string); ok && ref != "" {
+				refs[ref] = true
+			}
+		}
+	}
+
+	for i, _s := range sources {
+		s, ok := _s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		helm, ok := s["helm"].([]interface{})
+		if !ok || len(helm) == 0 || helm[0] == nil {
+			continue
+		}
+
+		valueFiles, ok := helm[0].(map[string]interface{})["value_files"].([]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, _vf := range valueFiles {
+			vf, ok := _vf.(string)
+			if !ok || !strings.HasPrefix(vf, "$") {
+				continue
+			}
+
+			ref := strings.SplitN(strings.TrimPrefix(vf, "$"), "/", 2)[0]
+			if !refs[ref] {
+				return fmt.Errorf("spec.0.source.%d.helm.0.value_files: value file %q references source %q, but no source with ref = %q exists", i, vf, "$"+ref, ref)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateApplicationHelmFileParameters ensures that Helm file parameters
+// either reference a file `path` or provide inline `content`. As inline
+// contents are sent as string parameters, their names must not collide with
+// the ones of the `parameter` blocks.
+func validateApplicationHelmFileParameters(d *schema.ResourceDiff) error {
+	sources, ok := d.Get("spec.0.source").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	for i := range sources {
+		fps, ok := d.Get(fmt.Sprintf("spec.0.source.%d.helm.0.file_parameter", i)).(*schema.Set)
+		if !ok || !d.NewValueKnown(fmt.Sprintf("spec.0.source.%d.helm.0.file_parameter", i)) {
+			continue
+		}
+
+		parameters := make(map[string]bool)
+
+		if ps, ok := d.Get(fmt.Sprintf("spec.0.source.%d.helm.0.parameter", i)).(*schema.Set); ok {
+			for _, _p := range ps.List() {
+				if p, ok := _p.(map[string]interface{}); ok {
+					parameters[p["name"].(string)] = true
+				}
+			}
+		}
+
+		for _, _fp := range fps.List() {
+			fp := _fp.(map[string]interface{})
+			if (fp["path"].(string) == "") == (fp["content"].(string) == "") {
+				return fmt.Errorf("spec.0.source.%d.helm.0.file_parameter: exactly one of `path` or `content` must be set for file parameter %q", i, fp["name"])
+			}
+
+			if fp["content"].(string) != "" && parameters[fp["name"].(string)] {
+				return fmt.Errorf("spec.0.source.%d.helm.0.file_parameter: file parameter %q with inline `content` cannot have the same name as a `parameter`", i, fp["name"])
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArgoCDApplicationImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	appName, namespace, err := parseApplicationImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if namespace == "" {
+		// Resolve the namespace ArgoCD defaults to (i.e. the control plane
+		// namespace) for applications imported by bare name.
+		si := meta.(*provider.ServerInterface)
+		if diags := si.InitClients(ctx); diags != nil {
+			return nil, fmt.Errorf("failed to initialize clients: %s", diags[0].Detail())
+		}
+
+		app, err := si.ApplicationClient.Get(ctx, &applicationClient.ApplicationQuery{
+			Name: &appName,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get application %s: %w", appName, err)
+		}
+
+		namespace = app.Namespace
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", appName, namespace))
+
+	// Defaults are not applied upon import, while these attributes are never
+	// read back from ArgoCD
+	for k, v := range map[string]interface{}{
+		"validate":                       true,
+		"deletion_protection":            false,
+		"allow_appset_owned":             false,
+		"refresh_on_read":                "none",
+		"drift_detection":                "none",
+		"terminate_operation_on_timeout": false,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseApplicationImportID extracts the application name and namespace from
+// an import ID. Supported formats are `name:namespace`, `namespace/name`, a
+// bare `name` (in which case the returned namespace is empty) and ArgoCD UI
+// URLs (e.g. `https://argocd.example.com/applications/argocd/guestbook`).
+func parseApplicationImportID(id string) (string, string, error) {
+	if strings.Contains(id, "://") {
+		u, err := url.Parse(id)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid application URL %q: %w", id, err)
+		}
+
+		parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+
+		for i, p := range parts {
+			if p != "applications" {
+				continue
+			}
+
+			switch len(parts) - i - 1 {
+			case 0:
+			case 1:
+				return parts[i+1], "", nil
+			default:
+				return parts[i+2], parts[i+1], nil
+			}
+		}
+
+		return "", "", fmt.Errorf("invalid application URL %q, expected format is <server>/applications/<namespace>/<name>", id)
+	}
+
+	return parseNamespacedImportID(id, "application")
+}
+
+// parseNamespacedImportID extracts the name and namespace of a namespaced
+// resource from an import ID. Supported formats are `name:namespace`,
+// `namespace/name` and a bare `name` (in which case the returned namespace is
+// empty).
+func parseNamespacedImportID(id, resource string) (string, string, error) {
+	var name, namespace string
+
+	switch {
+	case strings.Contains(id, ":"):
+		name, namespace, _ = strings.Cut(id, ":")
+	case strings.Contains(id, "/"):
+		namespace, name, _ = strings.Cut(id, "/")
+	default:
+		name = id
+	}
+
+	if name == "" || strings.ContainsAny(name, ":/") || strings.ContainsAny(namespace, ":/") || (namespace == "" && name != id) {
+		return "", "", fmt.Errorf("invalid %s import ID %q, expected format is <name>:<namespace>, <namespace>/<name> or <name>", resource, id)
+	}
+
+	return name, namespace, nil
+}
+
 func resourceArgoCDApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objectMeta, spec, err := expandApplication(d)
 	if err != nil {
@@ -80,34 +666,19 @@ func resourceArgoCDApplicationCreate(ctx context.Context, d *schema.ResourceData
 		return pluginSDKDiags(diags)
 	}
 
-	apps, err := si.ApplicationClient.List(ctx, &applicationClient.ApplicationQuery{
-		Name:         &objectMeta.Name,
-		AppNamespace: &objectMeta.Namespace,
-	})
-	if err != nil && !strings.Contains(err.Error(), "NotFound") {
-		return errorToDiagnostics(fmt.Sprintf("failed to list existing applications when creating application %s", objectMeta.Name), err)
+	existing, err := getApplication(ctx, si, objectMeta.Name, objectMeta.Namespace)
+	if err != nil {
+		return errorToDiagnostics(fmt.Sprintf("failed to get existing application when creating application %s", objectMeta.Name), err)
 	}
 
-	if apps != nil {
-		l := len(apps.Items)
+	if existing != nil {
+		if owner := applicationSetOwner(existing); owner != "" && !d.Get("allow_appset_owned").(bool) {
+			return applicationSetOwnedError(objectMeta.Name, owner)
+		}
 
-		switch {
-		case l < 1:
-			break
-		case l == 1:
-			switch apps.Items[0].DeletionTimestamp {
-			case nil:
-			default:
-				// Pre-existing app is still in Kubernetes soft deletion queue
-				time.Sleep(time.Duration(*apps.Items[0].DeletionGracePeriodSeconds))
-			}
-		case l > 1:
-			return []diag.Diagnostic{
-				{
-					Severity: diag.Error,
-					Summary:  fmt.Sprintf("found multiple applications matching name '%s' and namespace '%s'", objectMeta.Name, objectMeta.Namespace),
-				},
-			}
+		if existing.DeletionTimestamp != nil && existing.DeletionGracePeriodSeconds != nil {
+			// Pre-existing app is still in Kubernetes soft deletion queue
+			time.Sleep(time.Duration(*existing.DeletionGracePeriodSeconds))
 		}
 	}
 
@@ -125,6 +696,15 @@ func resourceArgoCDApplicationCreate(ctx context.Context, d *schema.ResourceData
 		return featureNotSupported(features.ManagedNamespaceMetadata)
 	}
 
+	if err := waitForApplicationDependencies(ctx, si, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return errorToDiagnostics(fmt.Sprintf("error while waiting for dependencies of application %s to be healthy", objectMeta.Name), err)
+	}
+
+	objectMeta.Finalizers = expandApplicationDeletionFinalizer(d.Get("deletion_finalizer").(string), nil)
+
+	validate := d.Get("validate").(bool)
+	createdAt := time.Now()
+
 	app, err := si.ApplicationClient.Create(ctx, &applicationClient.ApplicationCreateRequest{
 		Application: &application.Application{
 			ObjectMeta: objectMeta,
@@ -134,6 +714,7 @@ func resourceArgoCDApplicationCreate(ctx context.Context, d *schema.ResourceData
 				APIVersion: "argoproj.io/v1alpha1",
 			},
 		},
+		Validate: &validate,
 	})
 
 	if err != nil {
@@ -149,7 +730,24 @@ func resourceArgoCDApplicationCreate(ctx context.Context, d *schema.ResourceData
 
 	d.SetId(fmt.Sprintf("%s:%s", app.Name, objectMeta.Namespace))
 
-	return resourceArgoCDApplicationFakeRead(ctx, d, meta)
+	if v, ok := d.Get("initial_sync").([]interface{}); ok && len(v) > 0 {
+		_, err = si.ApplicationClient.Sync(ctx, expandApplicationSyncRequest(app.Name, objectMeta.Namespace, v[0]))
+		if err != nil {
+			return argoCDAPIError("sync", "application", app.Name, err)
+		}
+	}
+
+	if wait, ok := d.GetOk("wait"); ok && wait.(bool) {
+		if err := waitForApplication(ctx, si, d, objectMeta.Name, objectMeta.Namespace, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return errorToDiagnostics(fmt.Sprintf("error while waiting for application %s to be created", objectMeta.Name), err)
+		}
+	}
+
+	if err := checkApplicationConditions(ctx, si, d, objectMeta.Name, objectMeta.Namespace, createdAt, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return errorToDiagnostics(fmt.Sprintf("application %s was created with failing conditions", objectMeta.Name), err)
+	}
+
+	return append(applicationSyncPolicyAutomatedWarnings(spec), resourceArgoCDApplicationFakeRead(ctx, d, meta)...)
 }
 
 func resourceArgoCDApplicationFakeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -166,46 +764,83 @@ func resourceArgoCDApplicationRead(ctx context.Context, d *schema.ResourceData,
 	appName := ids[0]
 	namespace := ids[1]
 
-	apps, err := si.ApplicationClient.List(ctx, &applicationClient.ApplicationQuery{
-		Name:         &appName,
-		AppNamespace: &namespace,
-	})
-	if err != nil {
-		if strings.Contains(err.Error(), "NotFound") {
-			d.SetId("")
-			return diag.Diagnostics{}
+	if refresh := d.Get("refresh_on_read").(string); refresh != "" && refresh != "none" {
+		_, err := si.ApplicationClient.Get(ctx, &applicationClient.ApplicationQuery{
+			Name:         &appName,
+			AppNamespace: &namespace,
+			Refresh:      &refresh,
+		})
+		if err != nil {
+			if strings.Contains(err.Error(), "NotFound") {
+				d.SetId("")
+				return diag.Diagnostics{}
+			}
+
+			return argoCDAPIError("refresh", "application", appName, err)
 		}
+	}
 
+	app, err := getApplication(ctx, si, appName, namespace)
+	if err != nil {
 		return argoCDAPIError("read", "application", appName, err)
+	} else if app == nil {
+		d.SetId("")
+		return diag.Diagnostics{}
 	}
 
-	l := len(apps.Items)
+	err = flattenApplication(app, d)
+	if err != nil {
+		return errorToDiagnostics(fmt.Sprintf("failed to flatten application %s", appName), err)
+	}
 
-	switch {
-	case l < 1:
-		d.SetId("")
-		return diag.Diagnostics{}
-	case l == 1:
-		break
-	case l > 1:
+	if d.Get("drift_detection").(string) != "warn" {
+		return nil
+	}
+
+	drifted, err := applicationDriftedResources(ctx, si, appName, namespace)
+	if err != nil {
+		return argoCDAPIError("diff", "application", appName, err)
+	}
+
+	if len(drifted) > 0 {
 		return []diag.Diagnostic{
 			{
-				Severity: diag.Error,
-				Summary:  fmt.Sprintf("found multiple applications matching name '%s' and namespace '%s'", appName, namespace),
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("application %s has drifted from its target state", appName),
+				Detail:   fmt.Sprintf("The following resources differ from the target revision:\n  - %s", strings.Join(drifted, "\n  - ")),
 			},
 		}
 	}
 
-	err = flattenApplication(&apps.Items[0], d)
+	return nil
+}
+
+// applicationDriftedResources returns the application resources whose live
+// state differs from the target state, as computed by ArgoCD.
+func applicationDriftedResources(ctx context.Context, si *provider.ServerInterface, appName, namespace string) ([]string, error) {
+	resources, err := si.ApplicationClient.ManagedResources(ctx, &applicationClient.ResourcesQuery{
+		ApplicationName: &appName,
+		AppNamespace:    &namespace,
+	})
 	if err != nil {
-		return errorToDiagnostics(fmt.Sprintf("failed to flatten application %s", appName), err)
+		return nil, err
 	}
 
-	return nil
+	var drifted []string
+
+	for _, r := range resources.Items {
+		if r == nil || !r.Modified {
+			continue
+		}
+
+		drifted = append(drifted, fmt.Sprintf("%s/%s %s/%s", r.Group, r.Kind, r.Namespace, r.Name))
+	}
+
+	return drifted, nil
 }
 
 func resourceArgoCDApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if ok := d.HasChanges("metadata", "spec"); !ok {
+	if ok := d.HasChanges("metadata", "spec", "deletion_finalizer", "paused"); !ok {
 		return resourceArgoCDApplicationRead(ctx, d, meta)
 	}
 
@@ -239,27 +874,29 @@ func resourceArgoCDApplicationUpdate(ctx context.Context, d *schema.ResourceData
 		return featureNotSupported(features.ManagedNamespaceMetadata)
 	}
 
-	apps, err := si.ApplicationClient.List(ctx, appQuery)
+	if err := waitForApplicationDependencies(ctx, si, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return errorToDiagnostics(fmt.Sprintf("error while waiting for dependencies of application %s to be healthy", objectMeta.Name), err)
+	}
+
+	existing, err := getApplication(ctx, si, *appQuery.Name, *appQuery.AppNamespace)
 	if err != nil {
-		return []diag.Diagnostic{
-			{
-				Severity: diag.Error,
-				Summary:  "failed to get application",
-				Detail:   err.Error(),
-			},
-		}
+		return argoCDAPIError("read", "application", *appQuery.Name, err)
 	}
 
-	if len(apps.Items) > 1 {
-		return []diag.Diagnostic{
-			{
-				Severity: diag.Error,
-				Summary:  fmt.Sprintf("found multiple applications matching name '%s' and namespace '%s'", *appQuery.Name, *appQuery.AppNamespace),
-				Detail:   err.Error(),
-			},
+	var finalizers []string
+	if existing != nil {
+		if owner := applicationSetOwner(existing); owner != "" && !d.Get("allow_appset_owned").(bool) {
+			return applicationSetOwnedError(objectMeta.Name, owner)
 		}
+
+		finalizers = existing.Finalizers
 	}
 
+	objectMeta.Finalizers = expandApplicationDeletionFinalizer(d.Get("deletion_finalizer").(string), finalizers)
+
+	validate := d.Get("validate").(bool)
+	updatedAt := time.Now()
+
 	_, err = si.ApplicationClient.Update(ctx, &applicationClient.ApplicationUpdateRequest{
 		Application: &application.Application{
 			ObjectMeta: objectMeta,
@@ -269,6 +906,7 @@ func resourceArgoCDApplicationUpdate(ctx context.Context, d *schema.ResourceData
 				APIVersion: "argoproj.io/v1alpha1",
 			},
 		},
+		Validate: &validate,
 	})
 
 	if err != nil {
@@ -277,7 +915,17 @@ func resourceArgoCDApplicationUpdate(ctx context.Context, d *schema.ResourceData
 
 	time.Sleep(60 * time.Second)
 
-	return resourceArgoCDApplicationRead(ctx, d, meta)
+	if wait, ok := d.GetOk("wait"); ok && wait.(bool) {
+		if err := waitForApplication(ctx, si, d, *appQuery.Name, *appQuery.AppNamespace, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return errorToDiagnostics(fmt.Sprintf("error while waiting for application %s to be updated", objectMeta.Name), err)
+		}
+	}
+
+	if err := checkApplicationConditions(ctx, si, d, *appQuery.Name, *appQuery.AppNamespace, updatedAt, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return errorToDiagnostics(fmt.Sprintf("application %s was updated with failing conditions", objectMeta.Name), err)
+	}
+
+	return append(applicationSyncPolicyAutomatedWarnings(spec), resourceArgoCDApplicationRead(ctx, d, meta)...)
 }
 
 func resourceArgoCDApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -291,6 +939,22 @@ func resourceArgoCDApplicationDelete(ctx context.Context, d *schema.ResourceData
 	namespace := ids[1]
 	cascade := d.Get("cascade").(bool)
 
+	if d.Get("deletion_protection").(bool) {
+		return []diag.Diagnostic{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("application %s is protected against deletion", appName),
+				Detail:   "Set `deletion_protection = false` and apply the change before deleting the application.",
+			},
+		}
+	}
+
+	if d.Get("terminate_operation_on_timeout").(bool) {
+		if err := terminateApplicationOperation(ctx, si, appName, namespace); err != nil {
+			return argoCDAPIError("terminate operation of", "application", appName, err)
+		}
+	}
+
 	_, err := si.ApplicationClient.Delete(ctx, &applicationClient.ApplicationDeleteRequest{
 		Name:         &appName,
 		Cascade:      &cascade,
@@ -301,29 +965,348 @@ func resourceArgoCDApplicationDelete(ctx context.Context, d *schema.ResourceData
 		return argoCDAPIError("delete", "application", appName, err)
 	}
 
-	_ = retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
-		apps, err := si.ApplicationClient.List(ctx, &applicationClient.ApplicationQuery{
+	var forceDeleteAt time.Time
+
+	if v, ok := d.GetOk("force_delete_after"); ok && cascade {
+		after, _ := time.ParseDuration(v.(string))
+		forceDeleteAt = time.Now().Add(after)
+	}
+
+	forced := false
+
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+		app, err := getApplication(ctx, si, appName, namespace)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		} else if app == nil {
+			return nil
+		}
+
+		if !forced && !forceDeleteAt.IsZero() && time.Now().After(forceDeleteAt) {
+			if err := removeApplicationFinalizers(ctx, si, appName, namespace); err != nil {
+				return retry.NonRetryableError(fmt.Errorf("failed to remove finalizers of application %s: %w", appName, err))
+			}
+
+			forced = true
+		}
+
+		if !cascade {
+			return retry.RetryableError(fmt.Errorf("application %s is still present", appName))
+		}
+
+		return retry.RetryableError(fmt.Errorf("application %s is still present, %s", appName, applicationRemainingResources(ctx, si, appName, namespace)))
+	})
+	if err != nil {
+		return errorToDiagnostics(fmt.Sprintf("error while waiting for application %s to be deleted", appName), err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// getApplication retrieves an application through the Get API, which unlike
+// List does not scan all the applications known to the server. A nil
+// application is returned when it does not exist.
+func getApplication(ctx context.Context, si *provider.ServerInterface, appName, namespace string) (*application.Application, error) {
+	app, err := si.ApplicationClient.Get(ctx, &applicationClient.ApplicationQuery{
+		Name:         &appName,
+		AppNamespace: &namespace,
+	})
+
+	switch {
+	case err == nil:
+		return app, nil
+	case strings.Contains(err.Error(), "NotFound"):
+		return nil, nil
+	case !strings.Contains(err.Error(), "PermissionDenied"):
+		return nil, err
+	}
+
+	// ArgoCD denies access to applications that do not exist rather than
+	// reporting them as not found, which only List can tell apart.
+	apps, lErr := si.ApplicationClient.List(ctx, &applicationClient.ApplicationQuery{
+		Name:         &appName,
+		AppNamespace: &namespace,
+	})
+	if lErr != nil || apps == nil {
+		return nil, err
+	}
+
+	for i := range apps.Items {
+		if apps.Items[i].Name == appName && apps.Items[i].Namespace == namespace {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// applicationSetOwner returns the name of the ApplicationSet owning the
+// application, if any. ApplicationSet generated applications are identified
+// by their owner references, as ArgoCD does not set a dedicated tracking label
+// on them.
+func applicationSetOwner(app *application.Application) string {
+	for _, o := range app.OwnerReferences {
+		if o.Kind == application.ApplicationSetSchemaGroupVersionKind.Kind {
+			return o.Name
+		}
+	}
+
+	return ""
+}
+
+func applicationSetOwnedError(appName, owner string) diag.Diagnostics {
+	return []diag.Diagnostic{
+		{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("application %s is owned by ApplicationSet %s", appName, owner),
+			Detail:   "Any change made from Terraform would be reverted by the ApplicationSet controller. Manage the ApplicationSet instead, or set `allow_appset_owned = true` to manage the application regardless.",
+		},
+	}
+}
+
+// applicationRemainingResources describes the child resources of an
+// application that is being deleted which have not been removed yet.
+func applicationRemainingResources(ctx context.Context, si *provider.ServerInterface, appName, namespace string) string {
+	tree, err := si.ApplicationClient.ResourceTree(ctx, &applicationClient.ResourcesQuery{
+		ApplicationName: &appName,
+		AppNamespace:    &namespace,
+	})
+	if err != nil || tree == nil {
+		return "waiting for its resources to be deleted"
+	}
+
+	var remaining []string
+
+	for _, n := range tree.Nodes {
+		// Only report top level resources, i.e. the ones managed by the
+		// application rather than the ones they own (e.g. pods)
+		if len(n.ParentRefs) > 0 {
+			continue
+		}
+
+		remaining = append(remaining, fmt.Sprintf("%s/%s", n.Kind, n.Name))
+	}
+
+	if len(remaining) == 0 {
+		return "waiting for its finalizers to complete"
+	}
+
+	const maxReported = 10
+
+	if len(remaining) > maxReported {
+		return fmt.Sprintf("%d resources still terminating: %s, ...", len(remaining), strings.Join(remaining[:maxReported], ", "))
+	}
+
+	return fmt.Sprintf("%d resources still terminating: %s", len(remaining), strings.Join(remaining, ", "))
+}
+
+// removeApplicationFinalizers removes all the finalizers of an application,
+// which lets Kubernetes delete it without waiting for its resources.
+func removeApplicationFinalizers(ctx context.Context, si *provider.ServerInterface, appName, namespace string) error {
+	patch := `{"metadata":{"finalizers":null}}`
+	patchType := "merge"
+
+	_, err := si.ApplicationClient.Patch(ctx, &applicationClient.ApplicationPatchRequest{
+		Name:         &appName,
+		AppNamespace: &namespace,
+		Patch:        &patch,
+		PatchType:    &patchType,
+	})
+	if err != nil && !strings.Contains(err.Error(), "NotFound") {
+		return err
+	}
+
+	return nil
+}
+
+// waitForApplication blocks until the application is healthy and synced or,
+// when `wait_for` conditions are configured, until all the matching
+// application resources have reached the expected health status.
+func waitForApplication(ctx context.Context, si *provider.ServerInterface, d *schema.ResourceData, appName, namespace string, timeout time.Duration) error {
+	conditions := d.Get("wait_for").([]interface{})
+
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		app, err := getApplication(ctx, si, appName, namespace)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("error while waiting for application %s to be synced and healthy: %s", appName, err))
+		} else if app == nil {
+			return retry.NonRetryableError(fmt.Errorf("application %s does not exist in namespace %s", appName, namespace))
+		}
+
+		if len(conditions) == 0 {
+			if app.Status.Health.Status != health.HealthStatusHealthy {
+				return retry.RetryableError(fmt.Errorf("expected application health status to be healthy but was %s", app.Status.Health.Status))
+			}
+
+			if app.Status.Sync.Status != application.SyncStatusCodeSynced {
+				return retry.RetryableError(fmt.Errorf("expected application sync status to be synced but was %s", app.Status.Sync.Status))
+			}
+
+			return nil
+		}
+
+		for _, c := range conditions {
+			if err := applicationWaitConditionMet(app.Status.Resources, c.(map[string]interface{})); err != nil {
+				return retry.RetryableError(err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil && d.Get("terminate_operation_on_timeout").(bool) {
+		if tErr := terminateApplicationOperation(ctx, si, appName, namespace); tErr != nil {
+			return fmt.Errorf("%w (failed to terminate in-flight operation: %s)", err, tErr)
+		}
+	}
+
+	return err
+}
+
+// checkApplicationConditions waits for the application to be reconciled
+// after it was created or updated at the given time, and returns an error if
+// any of its conditions matches `fail_on_condition_types`.
+func checkApplicationConditions(ctx context.Context, si *provider.ServerInterface, d *schema.ResourceData, appName, namespace string, since time.Time, timeout time.Duration) error {
+	failOn := make(map[string]bool)
+	for _, t := range d.Get("fail_on_condition_types").([]interface{}) {
+		failOn[t.(string)] = true
+	}
+
+	if len(failOn) == 0 {
+		return nil
+	}
+
+	// Kubernetes timestamps have a one second precision
+	since = since.Truncate(time.Second)
+
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		app, err := si.ApplicationClient.Get(ctx, &applicationClient.ApplicationQuery{
 			Name:         &appName,
 			AppNamespace: &namespace,
 		})
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to get application %s: %w", appName, err))
+		}
+
+		var failing []string
 
-		switch err {
-		case nil:
-			if apps != nil && len(apps.Items) > 0 {
-				return retry.RetryableError(fmt.Errorf("application %s is still present", appName))
+		evaluated := app.Status.ReconciledAt != nil && !app.Status.ReconciledAt.Time.Before(since)
+
+		for _, c := range app.Status.Conditions {
+			if c.LastTransitionTime != nil && !c.LastTransitionTime.Time.Before(since) {
+				evaluated = true
 			}
-		default:
-			if !strings.Contains(err.Error(), "NotFound") {
-				return retry.NonRetryableError(err)
+
+			if failOn[c.Type] {
+				failing = append(failing, fmt.Sprintf("%s: %s", c.Type, c.Message))
 			}
 		}
 
-		d.SetId("")
+		if len(failing) > 0 {
+			return retry.NonRetryableError(fmt.Errorf("application %s reports the following conditions:\n  - %s", appName, strings.Join(failing, "\n  - ")))
+		}
+
+		if !evaluated {
+			return retry.RetryableError(fmt.Errorf("application %s has not been reconciled yet", appName))
+		}
 
 		return nil
 	})
+}
 
-	d.SetId("")
+// waitForApplicationDependencies blocks until all the applications listed in
+// `wait_for_applications` are healthy.
+func waitForApplicationDependencies(ctx context.Context, si *provider.ServerInterface, d *schema.ResourceData, timeout time.Duration) error {
+	deps := d.Get("wait_for_applications").([]interface{})
+	if len(deps) == 0 {
+		return nil
+	}
+
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		for _, dep := range deps {
+			appName, namespace, err := parseApplicationImportID(dep.(string))
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			query := &applicationClient.ApplicationQuery{
+				Name: &appName,
+			}
+
+			if namespace != "" {
+				query.AppNamespace = &namespace
+			}
+
+			app, err := si.ApplicationClient.Get(ctx, query)
+			if err != nil {
+				if strings.Contains(err.Error(), "NotFound") {
+					return retry.RetryableError(fmt.Errorf("application %s does not exist yet", dep))
+				}
+
+				return retry.NonRetryableError(fmt.Errorf("failed to get application %s: %w", dep, err))
+			}
+
+			if app.Status.Health.Status != health.HealthStatusHealthy {
+				return retry.RetryableError(fmt.Errorf("expected application %s health status to be healthy but was %s", dep, app.Status.Health.Status))
+			}
+		}
+
+		return nil
+	})
+}
+
+// terminateApplicationOperation terminates the operation currently running on
+// the application, if any.
+func terminateApplicationOperation(ctx context.Context, si *provider.ServerInterface, appName, namespace string) error {
+	_, err := si.ApplicationClient.TerminateOperation(ctx, &applicationClient.OperationTerminateRequest{
+		Name:         &appName,
+		AppNamespace: &namespace,
+	})
+	if err != nil && !strings.Contains(err.Error(), "No operation is in progress") && !strings.Contains(err.Error(), "NotFound") {
+		return err
+	}
+
+	return nil
+}
+
+func applicationWaitConditionMet(resources []application.ResourceStatus, condition map[string]interface{}) error {
+	kind := condition["kind"].(string)
+	expected := health.HealthStatusCode(condition["health"].(string))
+	matched := 0
+
+	for _, r := range resources {
+		if r.Kind != kind {
+			continue
+		}
+
+		if v := condition["group"].(string); v != "" && r.Group != v {
+			continue
+		}
+
+		if v := condition["name"].(string); v != "" && r.Name != v {
+			continue
+		}
+
+		if v := condition["namespace"].(string); v != "" && r.Namespace != v {
+			continue
+		}
+
+		matched++
+
+		if r.Health == nil || r.Health.Status != expected {
+			status := health.HealthStatusUnknown
+			if r.Health != nil {
+				status = r.Health.Status
+			}
+
+			return fmt.Errorf("expected %s %s/%s health status to be %s but was %s", r.Kind, r.Namespace, r.Name, expected, status)
+		}
+	}
+
+	if matched == 0 {
+		return fmt.Errorf("no application resource of kind %s matching wait condition found yet", kind)
+	}
 
 	return nil
 }
@@ -4,10 +4,15 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
+	applicationClient "github.com/dcoppa/argo-cd/v2/pkg/apiclient/application"
 	"github.com/dcoppa/argo-cd/v2/pkg/apiclient/applicationset"
 	application "github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/oboukili/terraform-provider-argocd/internal/features"
 	"github.com/oboukili/terraform-provider-argocd/internal/provider"
@@ -21,16 +26,135 @@ func resourceArgoCDApplicationSet() *schema.Resource {
 		ReadContext:   resourceArgoCDApplicationSetRead,
 		UpdateContext: resourceArgoCDApplicationSetUpdate,
 		DeleteContext: resourceArgoCDApplicationSetDelete,
+		CustomizeDiff: resourceArgoCDApplicationSetCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceArgoCDApplicationSetImportState,
 		},
 		Schema: map[string]*schema.Schema{
 			"metadata": metadataSchema("applicationsets.argoproj.io"),
 			"spec":     applicationSetSpecSchemaV0(),
+			"applications": {
+				Type:        schema.TypeList,
+				Description: "Applications generated by the application set, along with their destination and status.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the application.",
+							Computed:    true,
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Description: "Namespace of the application.",
+							Computed:    true,
+						},
+						"destination_server": {
+							Type:        schema.TypeString,
+							Description: "URL of the target cluster of the application.",
+							Computed:    true,
+						},
+						"destination_name": {
+							Type:        schema.TypeString,
+							Description: "Name of the target cluster of the application.",
+							Computed:    true,
+						},
+						"health_status": {
+							Type:        schema.TypeString,
+							Description: "Health status of the application.",
+							Computed:    true,
+						},
+						"sync_status": {
+							Type:        schema.TypeString,
+							Description: "Sync status of the application.",
+							Computed:    true,
+						},
+						"rolling_sync_step": {
+							Type:        schema.TypeString,
+							Description: "Index (starting at 1) of the `spec.strategy.rolling_sync.step` the application is updated in. Only set when a `RollingSync` strategy is configured.",
+							Computed:    true,
+						},
+						"rolling_sync_status": {
+							Type:        schema.TypeString,
+							Description: "Progressive sync status of the application, one of `Waiting`, `Pending`, `Progressing` or `Healthy`. Only set when a `RollingSync` strategy is configured.",
+							Computed:    true,
+						},
+						"rolling_sync_message": {
+							Type:        schema.TypeString,
+							Description: "Human-readable message about the progressive sync status of the application.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"wait": {
+				Type:        schema.TypeBool,
+				Description: "Upon application set deletion, wait for the generated applications to be removed, when set to true. The wait timeout is controlled by the Terraform Delete resource timeout (defaults to 5 minutes). If `spec.sync_policy.preserve_resources_on_deletion` is true, the resources of the generated applications are left in the destination clusters in any case.",
+				Optional:    true,
+				Default:     false,
+			},
+			"wait_for_healthy": {
+				Type:        schema.TypeBool,
+				Description: "Upon application set creation or update, wait for all the generated applications to be healthy/Synced, when set to true. Wait timeouts are controlled by Terraform Create and Update resource timeouts (both default to 5 minutes). **Note**: generated applications which are not automatically synced never become Synced, leading to an expected timeout.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 	}
 }
 
+func resourceArgoCDApplicationSetCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return validateApplicationSetListGenerators(d.GetRawConfig())
+}
+
+// validateApplicationSetListGenerators ensures that every list generator,
+// including the ones nested within matrix and merge generators, sets exactly
+// one of `elements` or `elements_yaml`. The raw configuration is inspected, as
+// an empty `elements` list cannot be told apart from an unset one otherwise.
+func validateApplicationSetListGenerators(v cty.Value) error {
+	if v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+
+	t := v.Type()
+
+	switch {
+	case t.IsObjectType():
+		if t.HasAttribute("elements") && t.HasAttribute("elements_yaml") {
+			elements, elementsYAML := v.GetAttr("elements"), v.GetAttr("elements_yaml")
+			if !elements.IsKnown() || !elementsYAML.IsKnown() {
+				return nil
+			}
+
+			yamlSet := !elementsYAML.IsNull() && elementsYAML.AsString() != ""
+			if elements.IsNull() == !yamlSet {
+				return fmt.Errorf("list generator: exactly one of `elements` or `elements_yaml` must be set")
+			}
+		}
+
+		for name := range t.AttributeTypes() {
+			if err := validateApplicationSetListGenerators(v.GetAttr(name)); err != nil {
+				return err
+			}
+		}
+	case t.IsListType() || t.IsSetType() || t.IsTupleType():
+		for it := v.ElementIterator(); it.Next(); {
+			_, e := it.Element()
+
+			if err := validateApplicationSetListGenerators(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceArgoCDApplicationSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	si := meta.(*provider.ServerInterface)
 	if diags := si.InitClients(ctx); diags != nil {
@@ -41,7 +165,7 @@ func resourceArgoCDApplicationSetCreate(ctx context.Context, d *schema.ResourceD
 		return featureNotSupported(features.ApplicationSet)
 	}
 
-	objectMeta, spec, err := expandApplicationSet(d, si.IsFeatureSupported(features.MultipleApplicationSources), si.IsFeatureSupported(features.ApplicationSetIgnoreApplicationDifferences))
+	objectMeta, spec, err := expandApplicationSet(d, si.IsFeatureSupported(features.MultipleApplicationSources))
 	if err != nil {
 		return errorToDiagnostics("failed to expand application set", err)
 	}
@@ -58,6 +182,22 @@ func resourceArgoCDApplicationSetCreate(ctx context.Context, d *schema.ResourceD
 		return featureNotSupported(features.ApplicationSetApplicationsSyncPolicy)
 	}
 
+	if !si.IsFeatureSupported(features.ApplicationSetPluginGenerator) && applicationSetUsesPluginGenerator(spec.Generators) {
+		return featureNotSupported(features.ApplicationSetPluginGenerator)
+	}
+
+	if !si.IsFeatureSupported(features.ApplicationSetAnyNamespace) && objectMeta.Namespace != "" {
+		return featureNotSupported(features.ApplicationSetAnyNamespace)
+	}
+
+	if !si.IsFeatureSupported(features.ApplicationSetTemplatePatch) && spec.TemplatePatch != nil {
+		return featureNotSupported(features.ApplicationSetTemplatePatch)
+	}
+
+	if !si.IsFeatureSupported(features.ApplicationSetGoTemplateOptions) && len(spec.GoTemplateOptions) > 0 {
+		return featureNotSupported(features.ApplicationSetGoTemplateOptions)
+	}
+
 	as, err := si.ApplicationSetClient.Create(ctx, &applicationset.ApplicationSetCreateRequest{
 		Applicationset: &application.ApplicationSet{
 			ObjectMeta: objectMeta,
@@ -79,7 +219,13 @@ func resourceArgoCDApplicationSetCreate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
-	d.SetId(as.Name)
+	d.SetId(applicationSetID(as.Name, objectMeta.Namespace))
+
+	if wait, ok := d.GetOk("wait_for_healthy"); ok && wait.(bool) {
+		if err := waitForApplicationSet(ctx, si, as.Name, objectMeta.Namespace, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return errorToDiagnostics(fmt.Sprintf("error while waiting for the generated applications of application set %s to be synced and healthy", as.Name), err)
+		}
+	}
 
 	return resourceArgoCDApplicationSetRead(ctx, d, meta)
 }
@@ -90,10 +236,11 @@ func resourceArgoCDApplicationSetRead(ctx context.Context, d *schema.ResourceDat
 		return pluginSDKDiags(diags)
 	}
 
-	name := d.Id()
+	name, namespace := parseApplicationSetID(d.Id())
 
 	appSet, err := si.ApplicationSetClient.Get(ctx, &applicationset.ApplicationSetGetQuery{
-		Name: name,
+		Name:            name,
+		AppsetNamespace: namespace,
 	})
 	if err != nil {
 		if strings.Contains(err.Error(), "NotFound") {
@@ -109,6 +256,15 @@ func resourceArgoCDApplicationSetRead(ctx context.Context, d *schema.ResourceDat
 		return errorToDiagnostics(fmt.Sprintf("failed to flatten application set %s", name), err)
 	}
 
+	apps, err := listApplicationSetApplications(ctx, si, appSet)
+	if err != nil {
+		return argoCDAPIError("list generated applications of", "application set", name, err)
+	}
+
+	if err := d.Set("applications", flattenApplicationSetApplications(apps, appSet.Status.ApplicationStatus)); err != nil {
+		return errorToDiagnostics(fmt.Sprintf("failed to persist generated applications of application set %s", name), err)
+	}
+
 	return nil
 }
 
@@ -126,7 +282,7 @@ func resourceArgoCDApplicationSetUpdate(ctx context.Context, d *schema.ResourceD
 		return nil
 	}
 
-	objectMeta, spec, err := expandApplicationSet(d, si.IsFeatureSupported(features.MultipleApplicationSources), si.IsFeatureSupported(features.ApplicationSetIgnoreApplicationDifferences))
+	objectMeta, spec, err := expandApplicationSet(d, si.IsFeatureSupported(features.MultipleApplicationSources))
 	if err != nil {
 		return errorToDiagnostics(fmt.Sprintf("failed to expand application set %s", d.Id()), err)
 	}
@@ -143,6 +299,24 @@ func resourceArgoCDApplicationSetUpdate(ctx context.Context, d *schema.ResourceD
 		return featureNotSupported(features.ApplicationSetApplicationsSyncPolicy)
 	}
 
+	if !si.IsFeatureSupported(features.ApplicationSetPluginGenerator) && applicationSetUsesPluginGenerator(spec.Generators) {
+		return featureNotSupported(features.ApplicationSetPluginGenerator)
+	}
+
+	_, namespace := parseApplicationSetID(d.Id())
+
+	if !si.IsFeatureSupported(features.ApplicationSetAnyNamespace) && namespace != "" {
+		return featureNotSupported(features.ApplicationSetAnyNamespace)
+	}
+
+	if !si.IsFeatureSupported(features.ApplicationSetTemplatePatch) && spec.TemplatePatch != nil {
+		return featureNotSupported(features.ApplicationSetTemplatePatch)
+	}
+
+	if !si.IsFeatureSupported(features.ApplicationSetGoTemplateOptions) && len(spec.GoTemplateOptions) > 0 {
+		return featureNotSupported(features.ApplicationSetGoTemplateOptions)
+	}
+
 	_, err = si.ApplicationSetClient.Create(ctx, &applicationset.ApplicationSetCreateRequest{
 		Applicationset: &application.ApplicationSet{
 			ObjectMeta: objectMeta,
@@ -159,6 +333,12 @@ func resourceArgoCDApplicationSetUpdate(ctx context.Context, d *schema.ResourceD
 		return argoCDAPIError("update", "application set", objectMeta.Name, err)
 	}
 
+	if wait, ok := d.GetOk("wait_for_healthy"); ok && wait.(bool) {
+		if err := waitForApplicationSet(ctx, si, objectMeta.Name, namespace, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return errorToDiagnostics(fmt.Sprintf("error while waiting for the generated applications of application set %s to be synced and healthy", objectMeta.Name), err)
+		}
+	}
+
 	return resourceArgoCDApplicationSetRead(ctx, d, meta)
 }
 
@@ -168,15 +348,276 @@ func resourceArgoCDApplicationSetDelete(ctx context.Context, d *schema.ResourceD
 		return pluginSDKDiags(diags)
 	}
 
-	_, err := si.ApplicationSetClient.Delete(ctx, &applicationset.ApplicationSetDeleteRequest{
-		Name: d.Id(),
+	name, namespace := parseApplicationSetID(d.Id())
+
+	as, err := si.ApplicationSetClient.Get(ctx, &applicationset.ApplicationSetGetQuery{
+		Name:            name,
+		AppsetNamespace: namespace,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			d.SetId("")
+			return nil
+		}
+
+		return argoCDAPIError("read", "application set", name, err)
+	}
+
+	generated, err := listApplicationSetApplications(ctx, si, as)
+	if err != nil {
+		return argoCDAPIError("list generated applications of", "application set", name, err)
+	}
+
+	_, err = si.ApplicationSetClient.Delete(ctx, &applicationset.ApplicationSetDeleteRequest{
+		Name:            name,
+		AppsetNamespace: namespace,
 	})
 
 	if err != nil && !strings.Contains(err.Error(), "NotFound") {
-		return argoCDAPIError("delete", "application set", d.Id(), err)
+		return argoCDAPIError("delete", "application set", name, err)
+	}
+
+	if wait, ok := d.GetOk("wait"); ok && wait.(bool) {
+		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+			remaining, err := listApplicationSetApplications(ctx, si, as)
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			if len(remaining) > 0 {
+				return retry.RetryableError(fmt.Errorf("generated applications are still present: %s", strings.Join(applicationIDs(remaining), ", ")))
+			}
+
+			return nil
+		})
+		if err != nil {
+			return errorToDiagnostics(fmt.Sprintf("error while waiting for the generated applications of application set %s to be deleted", name), err)
+		}
 	}
 
 	d.SetId("")
 
+	if preserve, ok := d.GetOk("spec.0.sync_policy.0.preserve_resources_on_deletion"); ok && preserve.(bool) && len(generated) > 0 {
+		return []diag.Diagnostic{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("resources of the applications generated by application set %s were preserved", name),
+				Detail:   fmt.Sprintf("As `preserve_resources_on_deletion` is enabled, the resources of the following applications were left in their destination clusters:\n  - %s", strings.Join(applicationIDs(generated), "\n  - ")),
+			},
+		}
+	}
+
 	return nil
 }
+
+func resourceArgoCDApplicationSetImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	name, namespace, err := parseNamespacedImportID(d.Id(), "application set")
+	if err != nil {
+		return nil, err
+	}
+
+	if namespace != "" {
+		// Application sets living in the namespace of the ArgoCD control
+		// plane are identified by their name only, so that their ID does not
+		// depend on the name of that namespace.
+		si := meta.(*provider.ServerInterface)
+		if diags := si.InitClients(ctx); diags != nil {
+			return nil, fmt.Errorf("failed to initialize clients: %s", diags[0].Detail())
+		}
+
+		as, err := si.ApplicationSetClient.Get(ctx, &applicationset.ApplicationSetGetQuery{
+			Name: name,
+		})
+
+		switch {
+		case err == nil && as.Namespace == namespace:
+			namespace = ""
+		case err != nil && !strings.Contains(err.Error(), "NotFound"):
+			return nil, fmt.Errorf("failed to get application set %s: %w", name, err)
+		}
+	}
+
+	d.SetId(applicationSetID(name, namespace))
+
+	// Defaults are not applied upon import, while `wait` and
+	// `wait_for_healthy` are never read back from ArgoCD
+	for _, k := range []string{"wait", "wait_for_healthy"} {
+		if err := d.Set(k, false); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// applicationSetID returns the ID of an application set. Application sets
+// living in the namespace of the ArgoCD control plane (i.e. without explicit
+// namespace) are identified by their name only, for backwards compatibility.
+func applicationSetID(name, namespace string) string {
+	if namespace == "" {
+		return name
+	}
+
+	return fmt.Sprintf("%s:%s", name, namespace)
+}
+
+// parseApplicationSetID returns the name and namespace of an application set
+// from its ID, see applicationSetID.
+func parseApplicationSetID(id string) (name, namespace string) {
+	name, namespace, _ = strings.Cut(id, ":")
+	return name, namespace
+}
+
+// waitForApplicationSet waits for the application set controller to have
+// reconciled the generated applications, and for all of them to be synced and
+// healthy.
+func waitForApplicationSet(ctx context.Context, si *provider.ServerInterface, name, namespace string, timeout time.Duration) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		as, err := si.ApplicationSetClient.Get(ctx, &applicationset.ApplicationSetGetQuery{
+			Name:            name,
+			AppsetNamespace: namespace,
+		})
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		upToDate := false
+
+		for _, c := range as.Status.Conditions {
+			switch {
+			case c.Type == application.ApplicationSetConditionErrorOccurred && c.Status == application.ApplicationSetConditionStatusTrue:
+				return retry.RetryableError(fmt.Errorf("application set controller reported an error: %s", c.Message))
+			case c.Type == application.ApplicationSetConditionResourcesUpToDate && c.Status == application.ApplicationSetConditionStatusTrue:
+				upToDate = true
+			}
+		}
+
+		if !upToDate {
+			return retry.RetryableError(fmt.Errorf("generated applications are not up to date yet"))
+		}
+
+		apps, err := listApplicationSetApplications(ctx, si, as)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		var pending []string
+
+		for _, app := range apps {
+			if app.Status.Health.Status != health.HealthStatusHealthy || app.Status.Sync.Status != application.SyncStatusCodeSynced {
+				pending = append(pending, fmt.Sprintf("%s:%s (%s/%s)", app.Name, app.Namespace, app.Status.Health.Status, app.Status.Sync.Status))
+			}
+		}
+
+		if len(pending) > 0 {
+			return retry.RetryableError(fmt.Errorf("%d out of %d generated applications are not synced and healthy yet: %s", len(pending), len(apps), strings.Join(pending, ", ")))
+		}
+
+		return nil
+	})
+}
+
+// listApplicationSetApplications returns the applications owned by the
+// application set.
+func listApplicationSetApplications(ctx context.Context, si *provider.ServerInterface, as *application.ApplicationSet) ([]application.Application, error) {
+	var owned []application.Application
+
+	if as == nil || as.UID == "" {
+		return owned, nil
+	}
+
+	apps, err := si.ApplicationClient.List(ctx, applicationSetApplicationsQuery(as))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, app := range apps.Items {
+		for _, o := range app.OwnerReferences {
+			if o.Kind == application.ApplicationSetSchemaGroupVersionKind.Kind && o.UID == as.UID {
+				owned = append(owned, app)
+				break
+			}
+		}
+	}
+
+	return owned, nil
+}
+
+// applicationSetApplicationsQuery returns the query listing the applications
+// which may have been generated by the application set. Generated applications
+// live in the namespace of their application set and, unless it is templated
+// or patched, belong to the project set in its template.
+func applicationSetApplicationsQuery(as *application.ApplicationSet) *applicationClient.ApplicationQuery {
+	q := &applicationClient.ApplicationQuery{}
+
+	if as.Namespace != "" {
+		namespace := as.Namespace
+		q.AppNamespace = &namespace
+	}
+
+	if p := as.Spec.Template.Spec.Project; p != "" && !strings.Contains(p, "{{") && as.Spec.TemplatePatch == nil {
+		q.Projects = []string{p}
+	}
+
+	return q
+}
+
+// applicationIDs returns the IDs (`<name>:<namespace>`) of the applications.
+func applicationIDs(apps []application.Application) []string {
+	ids := make([]string, len(apps))
+
+	for i, app := range apps {
+		ids[i] = fmt.Sprintf("%s:%s", app.Name, app.Namespace)
+	}
+
+	return ids
+}
+
+// applicationSetUsesPluginGenerator reports whether any of the generators,
+// including the ones nested in matrix and merge generators, is a plugin
+// generator.
+func applicationSetUsesPluginGenerator(gs []application.ApplicationSetGenerator) bool {
+	for _, g := range gs {
+		if g.Plugin != nil {
+			return true
+		}
+
+		var nested []application.ApplicationSetNestedGenerator
+
+		if g.Matrix != nil {
+			nested = append(nested, g.Matrix.Generators...)
+		}
+
+		if g.Merge != nil {
+			nested = append(nested, g.Merge.Generators...)
+		}
+
+		for _, n := range nested {
+			if n.Plugin != nil {
+				return true
+			}
+
+			var terminal []application.ApplicationSetTerminalGenerator
+
+			if n.Matrix != nil {
+				if mg, err := application.ToNestedMatrixGenerator(n.Matrix); err == nil && mg != nil {
+					terminal = append(terminal, mg.Generators...)
+				}
+			}
+
+			if n.Merge != nil {
+				if mg, err := application.ToNestedMergeGenerator(n.Merge); err == nil && mg != nil {
+					terminal = append(terminal, mg.Generators...)
+				}
+			}
+
+			for _, t := range terminal {
+				if t.Plugin != nil {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
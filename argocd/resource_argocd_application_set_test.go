@@ -1,6 +1,7 @@
 package argocd
 
 import (
+	"fmt"
 	"regexp"
 	"testing"
 
@@ -82,6 +83,11 @@ func TestAccArgoCDApplicationSet_clusterDecisionResource(t *testing.T) {
 						"argocd_application_set.cluster_decision_resource",
 						"spec.0.generator.0.cluster_decision_resource.0.label_selector.0.match_labels.%",
 					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.cluster_decision_resource",
+						"spec.0.generator.0.cluster_decision_resource.0.requeue_after_seconds",
+						"180",
+					),
 				),
 			},
 			{
@@ -94,6 +100,47 @@ func TestAccArgoCDApplicationSet_clusterDecisionResource(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDApplicationSet_customNamespace(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSetAnyNamespace) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationSet_customNamespace(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.custom_namespace",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.custom_namespace",
+						"metadata.0.namespace",
+						"mynamespace-1",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.custom_namespace",
+						"id",
+						"custom-namespace:mynamespace-1",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application_set.custom_namespace",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				ResourceName:            "argocd_application_set.custom_namespace",
+				ImportState:             true,
+				ImportStateId:           "mynamespace-1/custom-namespace",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
 func TestAccArgoCDApplicationSet_gitDirectories(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
@@ -118,6 +165,16 @@ func TestAccArgoCDApplicationSet_gitDirectories(t *testing.T) {
 						"argocd_application_set.git_directories",
 						"spec.0.generator.0.git.0.directory.1.exclude",
 					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.git_directories",
+						"spec.0.generator.0.git.0.requeue_after_seconds",
+						"300",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.git_directories",
+						"spec.0.generator.0.git.0.values.addon",
+						"{{path.basename}}",
+					),
 				),
 			},
 			{
@@ -190,6 +247,69 @@ func TestAccArgoCDApplicationSet_list(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDApplicationSet_listElementsYaml(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationSet_listElementsYaml(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.list_elements_yaml",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.list_elements_yaml",
+						"spec.0.generator.0.list.0.elements_yaml",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.list_elements_yaml",
+						"spec.0.generator.0.list.0.elements.#",
+						"0",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application_set.list_elements_yaml",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplicationSet_listElementsValidation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDApplicationSet_listElements(""),
+				ExpectError: regexp.MustCompile("exactly one of `elements` or `elements_yaml` must be set"),
+			},
+			{
+				Config: testAccArgoCDApplicationSet_listElements(`
+				elements      = [{ cluster = "engineering-dev" }]
+				elements_yaml = "- cluster: engineering-dev"`),
+				ExpectError: regexp.MustCompile("exactly one of `elements` or `elements_yaml` must be set"),
+			},
+			{
+				// An empty list of elements is valid, and generates no
+				// applications
+				Config: testAccArgoCDApplicationSet_listElements(`
+				elements = []`),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_application_set.list_elements",
+					"spec.0.generator.0.list.0.elements.#",
+					"0",
+				),
+			},
+		},
+	})
+}
+
 func TestAccArgoCDApplicationSet_matrix(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
@@ -292,6 +412,47 @@ func TestAccArgoCDApplicationSet_matrixNested(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDApplicationSet_matrixOfMerge(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationSet_matrixOfMerge(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.matrix_of_merge",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.matrix_of_merge",
+						"spec.0.generator.0.matrix.0.generator.0.git.0.repo_url",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.matrix_of_merge",
+						"spec.0.generator.0.matrix.0.generator.1.merge.0.merge_keys.0",
+						"server",
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.matrix_of_merge",
+						"spec.0.generator.0.matrix.0.generator.1.merge.0.generator.0.clusters.0.selector.0.match_labels.%",
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.matrix_of_merge",
+						"spec.0.generator.0.matrix.0.generator.1.merge.0.generator.1.list.0.elements.0.server",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application_set.matrix_of_merge",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
 func TestAccArgoCDApplicationSet_matrixInvalid(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
@@ -537,6 +698,11 @@ func TestAccArgoCDApplicationSet_scmProviderGithub(t *testing.T) {
 						"spec.0.generator.0.scm_provider.0.github.0.organization",
 						"myorg",
 					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.scm_github",
+						"spec.0.generator.0.scm_provider.0.requeue_after_seconds",
+						"600",
+					),
 				),
 			},
 			{
@@ -566,6 +732,16 @@ func TestAccArgoCDApplicationSet_scmProviderGitlab(t *testing.T) {
 						"spec.0.generator.0.scm_provider.0.gitlab.0.group",
 						"8675309",
 					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.scm_gitlab",
+						"spec.0.generator.0.scm_provider.0.gitlab.0.insecure",
+						"true",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.scm_gitlab",
+						"spec.0.generator.0.scm_provider.0.gitlab.0.include_shared_projects",
+						"true",
+					),
 				),
 			},
 			{
@@ -622,6 +798,45 @@ func TestAccArgoCDApplicationSet_scmProviderWithFilters(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDApplicationSet_pullRequestBitbucket(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationSet_pullRequestBitbucket(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.pr_bitbucket",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.pr_bitbucket",
+						"spec.0.generator.0.pull_request.0.bitbucket.0.owner",
+						"myworkspace",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.pr_bitbucket",
+						"spec.0.generator.0.pull_request.0.bitbucket.0.bearer_token.0.token_ref.0.secret_name",
+						"bitbucket-token",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.pr_bitbucket",
+						"spec.0.generator.0.pull_request.0.filter.0.target_branch_match",
+						"main",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application_set.pr_bitbucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
 func TestAccArgoCDApplicationSet_pullRequestBitbucketServer(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
@@ -702,6 +917,11 @@ func TestAccArgoCDApplicationSet_pullRequestGithub(t *testing.T) {
 						"spec.0.generator.0.pull_request.0.github.0.labels.0",
 						"preview",
 					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.pr_github",
+						"spec.0.generator.0.pull_request.0.requeue_after_seconds",
+						"600",
+					),
 				),
 			},
 			{
@@ -736,6 +956,11 @@ func TestAccArgoCDApplicationSet_pullRequestGitlab(t *testing.T) {
 						"spec.0.generator.0.pull_request.0.gitlab.0.labels.0",
 						"preview",
 					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.pr_gitlab",
+						"spec.0.generator.0.pull_request.0.gitlab.0.insecure",
+						"true",
+					),
 				),
 			},
 			{
@@ -748,6 +973,45 @@ func TestAccArgoCDApplicationSet_pullRequestGitlab(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDApplicationSet_plugin(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSetPluginGenerator) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationSet_plugin(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.plugin",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.plugin",
+						"spec.0.generator.0.plugin.0.config_map_ref",
+						"my-plugin",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.plugin",
+						"spec.0.generator.0.plugin.0.input.0.parameters.%",
+						"2",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.plugin",
+						"spec.0.generator.0.plugin.0.requeue_after_seconds",
+						"30",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application_set.plugin",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
 func TestAccArgoCDApplicationSet_mergeInvalid(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
@@ -805,7 +1069,10 @@ func TestAccArgoCDApplicationSet_generatorTemplate(t *testing.T) {
 
 func TestAccArgoCDApplicationSet_goTemplate(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckFeatureSupported(t, features.ApplicationSetGoTemplateOptions)
+		},
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
@@ -820,6 +1087,11 @@ func TestAccArgoCDApplicationSet_goTemplate(t *testing.T) {
 						"spec.0.go_template",
 						"true",
 					),
+					resource.TestCheckTypeSetElemAttr(
+						"argocd_application_set.go_template",
+						"spec.0.go_template_options.*",
+						"missingkey=error",
+					),
 				),
 			},
 			{
@@ -832,6 +1104,35 @@ func TestAccArgoCDApplicationSet_goTemplate(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDApplicationSet_templatePatch(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSetTemplatePatch) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationSet_templatePatch(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.template_patch",
+						"metadata.0.uid",
+					),
+					resource.TestMatchResourceAttr(
+						"argocd_application_set.template_patch",
+						"spec.0.template_patch",
+						regexp.MustCompile(`if eq .autoSync "true"`),
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application_set.template_patch",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
 func TestAccArgoCDApplicationSet_syncPolicy(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
@@ -861,6 +1162,60 @@ func TestAccArgoCDApplicationSet_syncPolicy(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDApplicationSet_wait(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationSet_wait(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.wait",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.wait",
+						"wait_for_healthy",
+						"true",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.wait",
+						"applications.0.health_status",
+						"Healthy",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.wait",
+						"applications.0.sync_status",
+						"Synced",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application_set.wait",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait", "wait_for_healthy"},
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.wait",
+						"applications.0.name",
+						"in-cluster-wait",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.wait",
+						"applications.0.destination_server",
+						"https://kubernetes.default.svc",
+					),
+				),
+			},
+		},
+	})
+}
+
 func TestAccArgoCDApplicationSet_syncPolicyWithApplicationsSyncPolicy(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -869,6 +1224,10 @@ func TestAccArgoCDApplicationSet_syncPolicyWithApplicationsSyncPolicy(t *testing
 		},
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDApplicationSet_syncPolicyWithInvalidApplicationsSync(),
+				ExpectError: regexp.MustCompile("expected spec.0.sync_policy.0.applications_sync to be one of"),
+			},
 			{
 				Config: testAccArgoCDApplicationSet_syncPolicyWithApplicationsSync(),
 				Check: resource.ComposeTestCheckFunc(
@@ -898,27 +1257,32 @@ func TestAccArgoCDApplicationSet_syncPolicyWithApplicationsSyncPolicy(t *testing
 	})
 }
 
-func TestAccArgoCDApplicationSet_progressiveSync(t *testing.T) {
+func TestAccArgoCDApplicationSet_preservedFields(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSetProgressiveSync) },
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSet) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationSet_progressiveSync(),
+				Config: testAccArgoCDApplicationSet_preservedFields(),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(
-						"argocd_application_set.progressive_sync",
+						"argocd_application_set.preserved_fields",
 						"metadata.0.uid",
 					),
 					resource.TestCheckResourceAttr(
-						"argocd_application_set.progressive_sync",
-						"spec.0.strategy.0.type",
-						"RollingSync",
+						"argocd_application_set.preserved_fields",
+						"spec.0.preserved_fields.0.annotations.0",
+						"notifications.argoproj.io/subscribe.on-sync-succeeded.slack",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.preserved_fields",
+						"spec.0.preserved_fields.0.labels.0",
+						"team",
 					),
 				),
 			},
 			{
-				ResourceName:            "argocd_application_set.progressive_sync",
+				ResourceName:            "argocd_application_set.preserved_fields",
 				ImportState:             true,
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
@@ -927,15 +1291,109 @@ func TestAccArgoCDApplicationSet_progressiveSync(t *testing.T) {
 	})
 }
 
-func testAccArgoCDApplicationSet_clusters() string {
-	return `
-resource "argocd_application_set" "clusters" {
-	metadata {
-		name = "clusters"
-	}
-	
-	spec {
-		generator {
+func TestAccArgoCDApplicationSet_ignoreApplicationDifferences(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckFeatureSupported(t, features.ApplicationSetIgnoreApplicationDifferences)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationSet_ignoreApplicationDifferences(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.ignore_application_differences",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.ignore_application_differences",
+						"spec.0.ignore_application_differences.#",
+						"2",
+					),
+					resource.TestCheckTypeSetElemAttr(
+						"argocd_application_set.ignore_application_differences",
+						"spec.0.ignore_application_differences.0.json_pointers.*",
+						"/spec/source/targetRevision",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.ignore_application_differences",
+						"spec.0.ignore_application_differences.1.name",
+						"some-app",
+					),
+					resource.TestCheckTypeSetElemAttr(
+						"argocd_application_set.ignore_application_differences",
+						"spec.0.ignore_application_differences.1.jq_path_expressions.*",
+						".spec.source.helm.values",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application_set.ignore_application_differences",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplicationSet_progressiveSync(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ApplicationSetProgressiveSync) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDApplicationSet_progressiveSyncInvalidType(),
+				ExpectError: regexp.MustCompile("expected spec.0.strategy.0.type to be one of"),
+			},
+			{
+				Config:      testAccArgoCDApplicationSet_progressiveSyncInvalidMaxUpdate(),
+				ExpectError: regexp.MustCompile("Percentage must not exceed 100%"),
+			},
+			{
+				Config: testAccArgoCDApplicationSet_progressiveSync(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application_set.progressive_sync",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.progressive_sync",
+						"spec.0.strategy.0.type",
+						"RollingSync",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.progressive_sync",
+						"spec.0.strategy.0.rolling_sync.0.step.#",
+						"3",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application_set.progressive_sync",
+						"spec.0.strategy.0.rolling_sync.0.step.2.max_update",
+						"10%",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application_set.progressive_sync",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
+func testAccArgoCDApplicationSet_clusters() string {
+	return `
+resource "argocd_application_set" "clusters" {
+	metadata {
+		name = "clusters"
+	}
+	
+	spec {
+		generator {
 			clusters {} # Automatically use all clusters defined within Argo CD
 		}
 	
@@ -1054,6 +1512,61 @@ resource "argocd_application_set" "cluster_decision_resource" {
 }`
 }
 
+func testAccArgoCDApplicationSet_customNamespace() string {
+	return `
+resource "argocd_project" "custom_namespace" {
+	metadata {
+		name      = "appset-custom-namespace"
+		namespace = "argocd"
+	}
+
+	spec {
+		description       = "project with source namespace"
+		source_repos      = ["*"]
+		source_namespaces = ["mynamespace-1"]
+
+		destination {
+			server    = "https://kubernetes.default.svc"
+			namespace = "default"
+		}
+	}
+}
+
+resource "argocd_application_set" "custom_namespace" {
+	metadata {
+		name      = "custom-namespace"
+		namespace = "mynamespace-1"
+	}
+	
+	spec {
+		generator {
+			clusters {} # Automatically use all clusters defined within Argo CD
+		}
+	
+		template {
+			metadata {
+				name = "{{name}}-custom-namespace"
+			}
+		
+			spec {
+				project = argocd_project.custom_namespace.metadata[0].name
+
+				source {
+					repo_url        = "https://github.com/argoproj/argocd-example-apps/"
+					target_revision = "HEAD"
+					path            = "guestbook"
+				}
+		
+				destination {
+					server    = "{{server}}"
+					namespace = "default"
+				}
+			}
+		}
+	}
+}`
+}
+
 func testAccArgoCDApplicationSet_scmProviderGitDirectories() string {
 	return `
 resource "argocd_application_set" "git_directories" {
@@ -1075,6 +1588,12 @@ resource "argocd_application_set" "git_directories" {
 					path = "applicationset/examples/git-generator-directory/excludes/cluster-addons/exclude-helm-guestbook"
 					exclude = true
 				}
+
+				requeue_after_seconds = "300"
+
+				values = {
+					addon = "{{path.basename}}"
+				}
 			} 
 		}
 	
@@ -1184,6 +1703,84 @@ resource "argocd_application_set" "list" {
 }`
 }
 
+func testAccArgoCDApplicationSet_listElementsYaml() string {
+	return `
+resource "argocd_application_set" "list_elements_yaml" {
+	metadata {
+		name = "list-elements-yaml"
+	}
+	
+	spec {
+		generator {
+			list {
+				elements_yaml = <<-EOT
+					- cluster: engineering-dev
+					  url: https://kubernetes.default.svc
+				EOT
+			}
+		}
+	
+		template {
+			metadata {
+				name = "{{cluster}}-elements-yaml"
+			}
+		
+			spec {
+				project = "default"
+		
+				source {
+					repo_url        = "https://github.com/argoproj/argocd-example-apps.git"
+					target_revision = "HEAD"
+					path            = "guestbook"
+				}
+		
+				destination {
+					server    = "{{url}}"
+					namespace = "default"
+				}
+			}
+		}
+	}
+}`
+}
+
+func testAccArgoCDApplicationSet_listElements(elements string) string {
+	return fmt.Sprintf(`
+resource "argocd_application_set" "list_elements" {
+	metadata {
+		name = "list-elements"
+	}
+	
+	spec {
+		generator {
+			list {%s
+			}
+		}
+	
+		template {
+			metadata {
+				name = "{{cluster}}-list-elements"
+			}
+		
+			spec {
+				project = "default"
+		
+				source {
+					repo_url        = "https://github.com/argoproj/argocd-example-apps.git"
+					target_revision = "HEAD"
+					path            = "guestbook"
+				}
+		
+				destination {
+					server    = "https://kubernetes.default.svc"
+					namespace = "default"
+				}
+			}
+		}
+	}
+}`, elements)
+}
+
 func testAccArgoCDApplicationSet_matrix() string {
 	return `
 resource "argocd_application_set" "matrix" {
@@ -1371,6 +1968,82 @@ resource "argocd_application_set" "matrix_nested" {
 }`
 }
 
+func testAccArgoCDApplicationSet_matrixOfMerge() string {
+	return `
+resource "argocd_application_set" "matrix_of_merge" {
+	metadata {
+		name = "matrix-of-merge"
+	}
+	
+	spec {
+		generator {
+			matrix {
+				generator {
+					git {
+						repo_url = "https://github.com/argoproj/argo-cd.git"
+						revision = "HEAD"
+
+						directory {
+							path = "applicationset/examples/matrix/cluster-addons/*"
+						}
+					}
+				}
+
+				generator {
+					merge {
+						merge_keys = [
+							"server"
+						]
+
+						generator {
+							clusters {
+								selector {
+									match_labels = {
+										"argocd.argoproj.io/secret-type" = "cluster"
+									}
+								}
+							}
+						}
+
+						generator {
+							list {
+								elements = [
+									{
+										server = "https://kubernetes.default.svc"
+										env    = "dev"
+									}
+								]
+							}
+						}
+					}
+				}
+			}
+		}
+	
+		template {
+			metadata {
+				name = "merged-{{path.basename}}-{{name}}"
+			}
+		
+			spec {
+				project = "default"
+		
+				source {
+					repo_url        = "https://github.com/argoproj/argo-cd.git"
+					target_revision = "HEAD"
+					path            = "{{path}}"
+				}
+		
+				destination {
+					server    = "{{server}}"
+					namespace = "{{path.basename}}"
+				}
+			}
+		}
+	}
+}`
+}
+
 func testAccArgoCDApplicationSet_matrixInsufficientGenerators() string {
 	return `
 resource "argocd_application_set" "matrix_insufficient_generators" {
@@ -2280,6 +2953,8 @@ resource "argocd_application_set" "scm_github" {
 						key         = "token"
 					}
 				}
+
+				requeue_after_seconds = "600"
 			}
 		}
 	  
@@ -2322,6 +2997,7 @@ resource "argocd_application_set" "scm_gitlab" {
 					api               = "https://gitlab.example.com/"
 					group             = "8675309"
 					include_subgroups = false
+					insecure          = true
 			
 					token_ref {
 						secret_name = "gitlab-token"
@@ -2421,17 +3097,69 @@ resource "argocd_application_set" "scm_filters" {
 }`
 }
 
-func testAccArgoCDApplicationSet_pullRequestBitbucketServer() string {
+func testAccArgoCDApplicationSet_pullRequestBitbucket() string {
 	return `
-resource "argocd_application_set" "pr_bitbucket_server" {
+resource "argocd_application_set" "pr_bitbucket" {
 	metadata {
-		name = "pr-bitbucket-server"
+		name = "pr-bitbucket"
 	}
-	
+
 	spec {
 		generator {
 			pull_request {
-				bitbucket_server {
+				bitbucket {
+					owner = "myworkspace"
+					repo  = "myrepository"
+
+					bearer_token {
+						token_ref {
+							secret_name = "bitbucket-token"
+							key         = "token"
+						}
+					}
+				}
+
+				filter {
+					target_branch_match = "main"
+				}
+			}
+		}
+
+		template {
+			metadata {
+				name = "myapp-{{branch}}-{{number}}"
+			}
+
+			spec {
+				project = "default"
+
+				source {
+					repo_url        = "https://bitbucket.org/myworkspace/myrepository.git"
+					path            = "kubernetes/"
+					target_revision = "{{head_sha}}"
+				}
+
+				destination {
+					server    = "https://kubernetes.default.svc"
+					namespace = "default"
+				}
+			}
+		}
+	}
+}`
+}
+
+func testAccArgoCDApplicationSet_pullRequestBitbucketServer() string {
+	return `
+resource "argocd_application_set" "pr_bitbucket_server" {
+	metadata {
+		name = "pr-bitbucket-server"
+	}
+	
+	spec {
+		generator {
+			pull_request {
+				bitbucket_server {
 					api     = "https://bitbucket.org/rest"
 					project = "myproject"
 					repo    = "myrepository"
@@ -2558,6 +3286,8 @@ resource "argocd_application_set" "pr_github" {
 						"preview"
 					]
 				}
+
+				requeue_after_seconds = "600"
 			}
 		}
 	
@@ -2606,6 +3336,7 @@ resource "argocd_application_set" "pr_gitlab" {
 					api                = "https://git.example.com/"
 					project            = "myproject"
 					pull_request_state = "opened"
+					insecure           = true
 			
 					token_ref {
 						secret_name = "gitlab-token"
@@ -2650,6 +3381,54 @@ resource "argocd_application_set" "pr_gitlab" {
 }`
 }
 
+func testAccArgoCDApplicationSet_plugin() string {
+	return `
+resource "argocd_application_set" "plugin" {
+	metadata {
+		name = "plugin"
+	}
+
+	spec {
+		generator {
+			plugin {
+				config_map_ref        = "my-plugin"
+				requeue_after_seconds = "30"
+
+				input {
+					parameters = {
+						key1 = jsonencode("value1")
+						key2 = jsonencode(["value2", "value3"])
+					}
+				}
+
+				values = {
+					value1 = "something"
+				}
+			}
+		}
+
+		template {
+			metadata {
+				name = "{{name}}-plugin"
+			}
+
+			spec {
+				source {
+					repo_url        = "https://github.com/argoproj/argocd-example-apps/"
+					target_revision = "HEAD"
+					path            = "guestbook"
+				}
+
+				destination {
+					server    = "https://kubernetes.default.svc"
+					namespace = "default"
+				}
+			}
+		}
+	}
+}`
+}
+
 func testAccArgoCDApplicationSet_generatorTemplate() string {
 	return `
 resource "argocd_application_set" "generator_template" {
@@ -2720,7 +3499,8 @@ resource "argocd_application_set" "go_template" {
 			clusters {} # Automatically use all clusters defined within Argo CD
 		}
 
-		go_template = true
+		go_template         = true
+		go_template_options = ["missingkey=error"]
 	
 		template {
 			metadata {
@@ -2744,6 +3524,64 @@ resource "argocd_application_set" "go_template" {
 }`
 }
 
+func testAccArgoCDApplicationSet_templatePatch() string {
+	return `
+resource "argocd_application_set" "template_patch" {
+	metadata {
+		name = "template-patch"
+	}
+	
+	spec {
+		generator {
+			list {
+				elements = [
+					{
+						cluster  = "engineering-dev"
+						url      = "https://kubernetes.default.svc"
+						autoSync = "true"
+					},
+					{
+						cluster  = "engineering-prod"
+						url      = "https://kubernetes.default.svc"
+						autoSync = "false"
+					}
+				]
+			}
+		}
+
+		go_template = true
+	
+		template {
+			metadata {
+				name = "appset-template-patch-{{.cluster}}"
+			}
+		
+			spec {
+				source {
+					repo_url        = "https://github.com/argoproj/argocd-example-apps/"
+					target_revision = "HEAD"
+					path            = "guestbook"
+				}
+		
+				destination {
+					server    = "{{.url}}"
+					namespace = "default"
+				}
+			}
+		}
+
+		template_patch = <<-EOT
+			spec:
+			  {{- if eq .autoSync "true" }}
+			  syncPolicy:
+			    automated:
+			      prune: true
+			  {{- end }}
+		EOT
+	}
+}`
+}
+
 func testAccArgoCDApplicationSet_syncPolicy() string {
 	return `
 resource "argocd_application_set" "sync_policy" {
@@ -2782,6 +3620,91 @@ resource "argocd_application_set" "sync_policy" {
 }`
 }
 
+func testAccArgoCDApplicationSet_wait() string {
+	return `
+resource "argocd_application_set" "wait" {
+	metadata {
+		name = "wait"
+	}
+	
+	spec {
+		generator {
+			clusters {} # Automatically use all clusters defined within Argo CD
+		}
+
+		sync_policy {
+			preserve_resources_on_deletion = true
+		}
+	
+		template {
+			metadata {
+				name = "{{name}}-wait"
+			}
+		
+			spec {
+				source {
+					repo_url        = "https://github.com/argoproj/argocd-example-apps/"
+					target_revision = "HEAD"
+					path            = "guestbook"
+				}
+		
+				destination {
+					server    = "{{server}}"
+					namespace = "default"
+				}
+
+				sync_policy {
+					automated {
+						prune = true
+					}
+				}
+			}
+		}
+	}
+
+	wait             = true
+	wait_for_healthy = true
+}`
+}
+
+func testAccArgoCDApplicationSet_syncPolicyWithInvalidApplicationsSync() string {
+	return `
+resource "argocd_application_set" "applications_sync_policy_invalid" {
+	metadata {
+		name = "applications-sync-policy-invalid"
+	}
+	
+	spec {
+		generator {
+			clusters {} # Automatically use all clusters defined within Argo CD
+		}
+
+		sync_policy {
+			applications_sync = "delete-only"
+		}
+	
+		template {
+			metadata {
+				name = "{{name}}-applications-sync-policy-invalid"
+			}
+		
+			spec {
+				source {
+					repo_url        = "https://github.com/argoproj/argocd-example-apps/"
+					target_revision = "HEAD"
+					path            = "guestbook"
+				}
+		
+				destination {
+					server    = "{{server}}"
+					namespace = "default"
+				}
+			}
+		}
+	}
+}`
+}
+
 func testAccArgoCDApplicationSet_syncPolicyWithApplicationsSync() string {
 	return `
 resource "argocd_application_set" "applications_sync_policy" {
@@ -2821,6 +3744,96 @@ resource "argocd_application_set" "applications_sync_policy" {
 }`
 }
 
+func testAccArgoCDApplicationSet_preservedFields() string {
+	return `
+resource "argocd_application_set" "preserved_fields" {
+	metadata {
+		name = "preserved-fields"
+	}
+	
+	spec {
+		generator {
+			clusters {} # Automatically use all clusters defined within Argo CD
+		}
+
+		preserved_fields {
+			annotations = [
+				"notifications.argoproj.io/subscribe.on-sync-succeeded.slack"
+			]
+			labels = [
+				"team"
+			]
+		}
+	
+		template {
+			metadata {
+				name = "{{name}}-preserved-fields"
+			}
+		
+			spec {
+				source {
+					repo_url        = "https://github.com/argoproj/argocd-example-apps/"
+					target_revision = "HEAD"
+					path            = "guestbook"
+				}
+		
+				destination {
+					server    = "{{server}}"
+					namespace = "default"
+				}
+			}
+		}
+	}
+}`
+}
+
+func testAccArgoCDApplicationSet_ignoreApplicationDifferences() string {
+	return `
+resource "argocd_application_set" "ignore_application_differences" {
+	metadata {
+		name = "ignore-application-differences"
+	}
+	
+	spec {
+		generator {
+			clusters {} # Automatically use all clusters defined within Argo CD
+		}
+
+		ignore_application_differences {
+			json_pointers = [
+				"/spec/source/targetRevision"
+			]
+		}
+
+		ignore_application_differences {
+			name = "some-app"
+			jq_path_expressions = [
+				".spec.source.helm.values"
+			]
+		}
+	
+		template {
+			metadata {
+				name = "{{name}}-ignore-application-differences"
+			}
+		
+			spec {
+				source {
+					repo_url        = "https://github.com/argoproj/argocd-example-apps/"
+					target_revision = "HEAD"
+					path            = "guestbook"
+				}
+		
+				destination {
+					server    = "{{server}}"
+					namespace = "default"
+				}
+			}
+		}
+	}
+}`
+}
+
 func testAccArgoCDApplicationSet_progressiveSync() string {
 	return `
 resource "argocd_application_set" "progressive_sync" {
@@ -2920,3 +3933,91 @@ resource "argocd_application_set" "progressive_sync" {
 	}
 }`
 }
+
+func testAccArgoCDApplicationSet_progressiveSyncInvalidType() string {
+	return `
+resource "argocd_application_set" "progressive_sync_invalid_type" {
+	metadata {
+		name = "progressive-sync-invalid-type"
+	}
+	
+	spec {
+		generator {
+			clusters {}
+		}
+	  
+		strategy {
+			type = "Canary"
+		}
+	  
+		template {
+			metadata {
+				name = "{{name}}-progressive-sync-invalid-type"
+			}
+	  
+			spec {
+				source {
+					repo_url        = "https://github.com/argoproj/argocd-example-apps/"
+					target_revision = "HEAD"
+					path            = "guestbook"
+				}
+	  
+				destination {
+					server    = "{{server}}"
+					namespace = "default"
+				}
+			}
+		}
+	}
+}`
+}
+
+func testAccArgoCDApplicationSet_progressiveSyncInvalidMaxUpdate() string {
+	return `
+resource "argocd_application_set" "progressive_sync_invalid_max_update" {
+	metadata {
+		name = "progressive-sync-invalid-max-update"
+	}
+
+	spec {
+		generator {
+			clusters {}
+		}
+
+		strategy {
+			type = "RollingSync"
+
+			rolling_sync {
+				step {
+					match_expressions {
+						key      = "envLabel"
+						operator = "In"
+						values   = ["env-dev"]
+					}
+
+					max_update = "150%"
+				}
+			}
+		}
+
+		template {
+			metadata {
+				name = "{{name}}-progressive-sync-invalid-max-update"
+			}
+
+			spec {
+				source {
+					repo_url        = "https://github.com/argoproj/argocd-example-apps/"
+					target_revision = "HEAD"
+					path            = "guestbook"
+				}
+
+				destination {
+					server    = "{{server}}"
+					namespace = "default"
+				}
+			}
+		}
+	}
+}`
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"testing"
 
+	application "github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/oboukili/terraform-provider-argocd/internal/features"
@@ -42,6 +43,20 @@ func TestAccArgoCDApplication(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status"},
 			},
+			{
+				ResourceName:            "argocd_application." + name,
+				ImportState:             true,
+				ImportStateId:           "argocd/" + name,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status"},
+			},
+			{
+				ResourceName:            "argocd_application." + name,
+				ImportState:             true,
+				ImportStateId:           name,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status"},
+			},
 			{
 				// Update
 				Config: testAccArgoCDApplicationSimple(name, "9.0.0", false),
@@ -81,6 +96,50 @@ func TestAccArgoCDApplication(t *testing.T) {
 						"status.0.sync.0.status",
 						"Synced",
 					),
+					resource.TestCheckResourceAttr(
+						"argocd_application."+name,
+						"health_status",
+						"Healthy",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application."+name,
+						"sync_status",
+						"Synced",
+					),
+					resource.TestCheckResourceAttrPair(
+						"argocd_application."+name,
+						"sync_revision",
+						"argocd_application."+name,
+						"status.0.sync.0.revision",
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_application."+name,
+						"images.#",
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_application."+name,
+						"status.0.resources.0.kind",
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_application."+name,
+						"status.0.resources.0.name",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application."+name,
+						"status.0.resources.0.status",
+						"Synced",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application."+name,
+						"last_operation_phase",
+						"Succeeded",
+					),
+					resource.TestCheckResourceAttrPair(
+						"argocd_application."+name,
+						"last_synced_revision",
+						"argocd_application."+name,
+						"status.0.operation_state.0.revision",
+					),
 				),
 			},
 			{
@@ -93,327 +152,306 @@ func TestAccArgoCDApplication(t *testing.T) {
 	})
 }
 
-func TestAccArgoCDApplication_Helm(t *testing.T) {
-	helmValues := `
-ingress:
-  enabled: true
-  path: /
-  hosts:
-    - mydomain.example.com
-  annotations:
-    kubernetes.io/ingress.class: nginx
-    kubernetes.io/tls-acme: "true"
-  labels: {}
-  tls:
-    - secretName: mydomain-tls
-      hosts:
-        - mydomain.example.com
-`
+func TestAccArgoCDApplication_WaitFor(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationHelm(
-					acctest.RandomWithPrefix("test-acc"),
-					helmValues),
+				Config: testAccArgoCDApplicationWaitFor(name),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.helm",
-						"metadata.0.uid",
-					),
 					resource.TestCheckResourceAttr(
-						"argocd_application.helm",
-						"spec.0.source.0.helm.0.values",
-						helmValues+"\n",
+						"argocd_application.wait_for",
+						"wait_for.0.kind",
+						"Deployment",
 					),
 					resource.TestCheckResourceAttr(
-						"argocd_application.helm",
-						"spec.0.source.0.helm.0.value_files.0",
-						"values.yaml",
+						"argocd_application.wait_for",
+						"wait_for.0.health",
+						"Healthy",
 					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application.wait_for",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait", "wait_for", "cascade", "status"},
+			},
+			{
+				Config:      testAccArgoCDApplicationWaitForInvalidHealth(name),
+				ExpectError: regexp.MustCompile("health status 'Green' is invalid"),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_RefreshOnRead(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationRefreshOnRead(name, "hard"),
+				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
-						"argocd_application.helm",
-						"spec.0.source.0.helm.0.pass_credentials",
-						"true",
+						"argocd_application.refresh",
+						"refresh_on_read",
+						"hard",
 					),
+				),
+			},
+			{
+				Config: testAccArgoCDApplicationRefreshOnRead(name, "normal"),
+				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
-						"argocd_application.helm",
-						"spec.0.source.0.helm.0.ignore_missing_value_files",
-						"true",
+						"argocd_application.refresh",
+						"refresh_on_read",
+						"normal",
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.refresh",
+						"status.0.reconciled_at",
 					),
 				),
 			},
 			{
-				ResourceName:            "argocd_application.helm",
+				ResourceName:            "argocd_application.refresh",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status"},
+				ImportStateVerifyIgnore: []string{"cascade", "refresh_on_read", "status"},
+			},
+			{
+				Config:      testAccArgoCDApplicationRefreshOnRead(name, "soft"),
+				ExpectError: regexp.MustCompile("refresh type 'soft' is invalid"),
 			},
 		},
 	})
 }
 
-func TestAccArgoCDApplication_Helm_FileParameters(t *testing.T) {
+func TestAccArgoCDApplication_DriftDetection(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationHelm_FileParameters(acctest.RandomWithPrefix("test-acc")),
-				// Setting up tests for this is non-trivial so it is easier to test for an expected failure (since file does not exist) than to test for success
-				ExpectError: regexp.MustCompile(`(?s)Error: failed parsing.*--set-file data`),
+				Config: testAccArgoCDApplicationDriftDetection(name, "warn"),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_application.drift",
+					"drift_detection",
+					"warn",
+				),
+			},
+			{
+				Config: testAccArgoCDApplicationDriftDetection(name, "error"),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_application.drift",
+					"drift_detection",
+					"error",
+				),
+			},
+			{
+				ResourceName:            "argocd_application.drift",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cascade", "drift_detection", "wait", "status"},
+			},
+			{
+				Config:      testAccArgoCDApplicationDriftDetection(name, "fail"),
+				ExpectError: regexp.MustCompile("drift detection mode 'fail' is invalid"),
 			},
 		},
 	})
 }
 
-func TestAccArgoCDApplication_Kustomize(t *testing.T) {
+func TestAccArgoCDApplication_TerminateOperationOnTimeout(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationKustomize(
-					acctest.RandomWithPrefix("test-acc")),
+				Config: testAccArgoCDApplicationTerminateOperationOnTimeout(name),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_application.terminate",
+					"terminate_operation_on_timeout",
+					"true",
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_WaitForApplications(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationWaitForApplications(name),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.kustomize",
-						"metadata.0.uid",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.kustomize",
-						"spec.0.source.0.target_revision",
-						"release-kustomize-v3.7",
+					resource.TestCheckResourceAttrPair(
+						"argocd_application.dependent",
+						"wait_for_applications.0",
+						"argocd_application.dependency",
+						"id",
 					),
 					resource.TestCheckResourceAttr(
-						"argocd_application.kustomize",
-						"spec.0.source.0.kustomize.0.name_suffix",
-						"-bar",
+						"argocd_application.dependent",
+						"wait_for_applications.1",
+						"argocd/"+name+"-dependency",
 					),
 				),
 			},
 			{
-				ResourceName:            "argocd_application.kustomize",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status"},
+				Config:      testAccArgoCDApplicationWaitForApplicationsInvalid(name),
+				ExpectError: regexp.MustCompile("application reference 'https://argocd.example.com/applications/argocd/app' is invalid"),
 			},
 		},
 	})
 }
 
-func TestAccArgoCDApplication_IgnoreDifferences(t *testing.T) {
+func TestAccArgoCDApplication_DeletionFinalizer(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationIgnoreDifferences(
-					acctest.RandomWithPrefix("test-acc")),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.ignore_differences",
-						"metadata.0.uid",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.ignore_differences",
-						"spec.0.ignore_difference.0.kind",
-						"Deployment",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.ignore_differences",
-						"spec.0.ignore_difference.1.group",
-						"apps",
-					),
+				Config: testAccArgoCDApplicationDeletionFinalizer(name, "foreground"),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_application.finalizer",
+					"deletion_finalizer",
+					"foreground",
 				),
 			},
 			{
-				ResourceName:            "argocd_application.ignore_differences",
+				ResourceName:            "argocd_application.finalizer",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "status"},
+				ImportStateVerifyIgnore: []string{"cascade", "status"},
 			},
 			{
-				Config: testAccArgoCDApplicationIgnoreDiffJQPathExpressions(
-					acctest.RandomWithPrefix("test-acc")),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.ignore_differences_jqpe",
-						"metadata.0.uid",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.ignore_differences_jqpe",
-						"spec.0.ignore_difference.0.jq_path_expressions.0",
-						".spec.replicas",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.ignore_differences_jqpe",
-						"spec.0.ignore_difference.1.jq_path_expressions.1",
-						".spec.template.spec.metadata.labels.somelabel",
-					),
+				Config: testAccArgoCDApplicationDeletionFinalizer(name, "background"),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_application.finalizer",
+					"deletion_finalizer",
+					"background",
 				),
 			},
 			{
-				ResourceName:            "argocd_application.ignore_differences_jqpe",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "status"},
+				Config: testAccArgoCDApplicationDeletionFinalizer(name, "none"),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_application.finalizer",
+					"deletion_finalizer",
+					"none",
+				),
+			},
+			{
+				Config:      testAccArgoCDApplicationDeletionFinalizer(name, "orphan"),
+				ExpectError: regexp.MustCompile("deletion finalizer 'orphan' is invalid"),
 			},
 		},
 	})
 }
 
-func TestAccArgoCDApplication_RevisionHistoryLimit(t *testing.T) {
-	revisionHistoryLimit := acctest.RandIntRange(0, 9)
+func TestAccArgoCDApplication_ForceDeleteAfter(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationRevisionHistory(
-					acctest.RandomWithPrefix("test-acc"),
-					revisionHistoryLimit,
-				),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.revision_history_limit",
-						"metadata.0.uid",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.revision_history_limit",
-						"spec.0.revision_history_limit",
-						fmt.Sprint(revisionHistoryLimit),
-					),
+				Config: testAccArgoCDApplicationForceDeleteAfter(name, "1m"),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_application.force_delete",
+					"force_delete_after",
+					"1m",
 				),
 			},
 			{
-				ResourceName:            "argocd_application.revision_history_limit",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "status"},
+				Config:      testAccArgoCDApplicationForceDeleteAfter(name, "soon"),
+				ExpectError: regexp.MustCompile("invalid duration 'soon'"),
 			},
 		},
 	})
 }
 
-func TestAccArgoCDApplication_OptionalDestinationNamespace(t *testing.T) {
+func TestAccArgoCDApplication_ApplicationSetOwned(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckFeatureSupported(t, features.ApplicationSet)
+		},
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplication_OptionalDestinationNamespace(acctest.RandomWithPrefix("test-acc")),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.no_namespace",
-						"metadata.0.uid",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.no_namespace",
-						"spec.0.destination.0.namespace",
-						"", // optional strings are maintained in state as blank strings
-					),
-				),
+				Config: testAccArgoCDApplicationApplicationSetOwned(name, false),
 			},
 			{
-				ResourceName:            "argocd_application.no_namespace",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "status"},
+				Config:      testAccArgoCDApplicationApplicationSetOwned(name, true),
+				ExpectError: regexp.MustCompile("is owned by ApplicationSet " + name),
 			},
 		},
 	})
 }
 
-func TestAccArgoCDApplication_DirectoryJsonnet(t *testing.T) {
+func TestAccArgoCDApplication_InitialSync(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplication_DirectoryJsonnet(
-					acctest.RandomWithPrefix("test-acc")),
+				Config: testAccArgoCDApplicationInitialSync(name),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.directory",
-						"metadata.0.uid",
+					resource.TestCheckResourceAttr(
+						"argocd_application.initial_sync",
+						"initial_sync.0.strategy",
+						"apply",
 					),
 					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.recurse",
-						"false",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.jsonnet.0.ext_var.0.name",
-						"somename",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.jsonnet.0.ext_var.0.value",
-						"somevalue",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.jsonnet.0.ext_var.0.code",
-						"false",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.jsonnet.0.ext_var.1.name",
-						"anothername",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.jsonnet.0.ext_var.1.value",
-						"anothervalue",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.jsonnet.0.ext_var.1.code",
-						"true",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.jsonnet.0.tla.0.name",
-						"yetanothername",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.jsonnet.0.tla.0.value",
-						"yetanothervalue",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.jsonnet.0.tla.0.code",
+						"argocd_application.initial_sync",
+						"initial_sync.0.prune",
 						"true",
 					),
+				),
+			},
+			{
+				// Refresh so that the status reflects the initial sync
+				Config: testAccArgoCDApplicationInitialSync(name),
+				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.jsonnet.0.libs.0",
-						"vendor",
+						"argocd_application.initial_sync",
+						"sync_status",
+						"Synced",
 					),
 					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.jsonnet.0.libs.1",
-						"foo",
+						"argocd_application.initial_sync",
+						"spec.0.sync_policy.#",
+						"0",
 					),
 				),
 			},
-			{
-				ResourceName:            "argocd_application.directory",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status"},
-			},
 		},
 	})
 }
 
-func TestAccArgoCDApplication_Recurse(t *testing.T) {
+func TestAccArgoCDApplication_FailOnConditionTypes(t *testing.T) {
 	name := acctest.RandomWithPrefix("test-acc")
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -421,79 +459,64 @@ func TestAccArgoCDApplication_Recurse(t *testing.T) {
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationRecurseDirectory(name, true),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.recurse",
-						"true",
-					),
-					resource.TestCheckNoResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.jsonnet.0",
-					),
-				),
+				Config:      testAccArgoCDApplicationFailOnConditionTypes(name, "does-not-exist", `["ComparisonError"]`),
+				ExpectError: regexp.MustCompile("ComparisonError"),
 			},
 			{
-				Config: testAccArgoCDApplicationDirectoryImplicitNonRecurse(acctest.RandomWithPrefix("test-acc")),
+				Config: testAccArgoCDApplicationFailOnConditionTypes(name, "guestbook", `["ComparisonError", "InvalidSpecError"]`),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.recurse",
-						"false",
+						"argocd_application.conditions",
+						"fail_on_condition_types.#",
+						"2",
 					),
 					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.jsonnet.0.ext_var.0.name",
-						"somename",
+						"argocd_application.conditions",
+						"status.0.conditions.#",
+						"0",
 					),
 				),
 			},
 			{
-				Config: testAccArgoCDApplicationRecurseDirectory(name, false),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckNoResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.recurse",
-					),
-					resource.TestCheckNoResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.jsonnet.0",
-					),
-				),
+				Config:      testAccArgoCDApplicationFailOnConditionTypes(name, "guestbook", `["NotACondition"]`),
+				ExpectError: regexp.MustCompile("application condition type 'NotACondition' is invalid"),
 			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_NoPerpetualDiff(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.MultipleApplicationSources) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationRecurseDirectory(name, true),
+				Config: testAccArgoCDApplicationServerDefaults(name),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.recurse",
-						"true",
+						"argocd_application.defaults",
+						"spec.0.source.0.helm.#",
+						"1",
 					),
-					resource.TestCheckNoResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.jsonnet.0",
+					resource.TestCheckResourceAttr(
+						"argocd_application.defaults",
+						"spec.0.sync_policy.0.sync_options.0",
+						"Validate=false",
 					),
 				),
 			},
 			{
-				Config: testAccArgoCDApplicationRecurseDirectory(name, false),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckNoResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.recurse",
-					),
-					resource.TestCheckNoResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.jsonnet.0",
-					),
-				),
+				// Refresh and expect no diff on server populated defaults
+				Config:   testAccArgoCDApplicationServerDefaults(name),
+				PlanOnly: true,
 			},
 		},
 	})
 }
 
-func TestAccArgoCDApplication_EmptyDirectory(t *testing.T) {
+func TestAccArgoCDApplication_DeletionProtection(t *testing.T) {
 	name := acctest.RandomWithPrefix("test-acc")
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -501,25 +524,32 @@ func TestAccArgoCDApplication_EmptyDirectory(t *testing.T) {
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplication_EmptyDirectory(name),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckNoResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.recurse",
-					),
+				Config: testAccArgoCDApplicationDeletionProtection(name, true),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_application.protected",
+					"deletion_protection",
+					"true",
 				),
 			},
 			{
-				ResourceName:            "argocd_application.directory",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status"},
+				Config:      testAccArgoCDApplicationDeletionProtection(name, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("is protected against deletion"),
+			},
+			{
+				// Unset the flag so that the application can be destroyed
+				Config: testAccArgoCDApplicationDeletionProtection(name, false),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_application.protected",
+					"deletion_protection",
+					"false",
+				),
 			},
 		},
 	})
 }
 
-func TestAccArgoCDApplication_DirectoryIncludeExclude(t *testing.T) {
+func TestAccArgoCDApplication_Paused(t *testing.T) {
 	name := acctest.RandomWithPrefix("test-acc")
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -527,82 +557,89 @@ func TestAccArgoCDApplication_DirectoryIncludeExclude(t *testing.T) {
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplication_DirectoryIncludeExclude(name),
+				Config: testAccArgoCDApplicationPaused(name, true),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.include",
-						"*.yaml",
+						"argocd_application.paused",
+						"paused",
+						"true",
 					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.directory.0.exclude",
-						"config.yaml",
+					resource.TestCheckNoResourceAttr(
+						"argocd_application.paused",
+						"metadata.0.annotations.argocd.argoproj.io/skip-reconcile",
 					),
 				),
 			},
 			{
-				ResourceName:            "argocd_application.directory",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status"},
+				Config:   testAccArgoCDApplicationPaused(name, true),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccArgoCDApplicationPaused(name, false),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_application.paused",
+					"paused",
+					"false",
+				),
 			},
 		},
 	})
 }
 
-func TestAccArgoCDApplication_SyncPolicy(t *testing.T) {
+func TestAccArgoCDApplication_Helm(t *testing.T) {
+	helmValues := `
+ingress:
+  enabled: true
+  path: /
+  hosts:
+    - mydomain.example.com
+  annotations:
+    kubernetes.io/ingress.class: nginx
+    kubernetes.io/tls-acme: "true"
+  labels: {}
+  tls:
+    - secretName: mydomain-tls
+      hosts:
+        - mydomain.example.com
+`
+
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationSyncPolicy(
-					acctest.RandomWithPrefix("test-acc")),
+				Config: testAccArgoCDApplicationHelm(
+					acctest.RandomWithPrefix("test-acc"),
+					helmValues),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(
-						"argocd_application.sync_policy",
+						"argocd_application.helm",
 						"metadata.0.uid",
 					),
 					resource.TestCheckResourceAttr(
-						"argocd_application.sync_policy",
-						"spec.0.sync_policy.0.automated.0.prune",
-						"true",
+						"argocd_application.helm",
+						"spec.0.source.0.helm.0.values",
+						helmValues+"\n",
 					),
 					resource.TestCheckResourceAttr(
-						"argocd_application.sync_policy",
-						"spec.0.sync_policy.0.automated.0.self_heal",
-						"true",
+						"argocd_application.helm",
+						"spec.0.source.0.helm.0.value_files.0",
+						"values.yaml",
 					),
 					resource.TestCheckResourceAttr(
-						"argocd_application.sync_policy",
-						"spec.0.sync_policy.0.automated.0.allow_empty",
+						"argocd_application.helm",
+						"spec.0.source.0.helm.0.pass_credentials",
 						"true",
 					),
 					resource.TestCheckResourceAttr(
-						"argocd_application.sync_policy",
-						"spec.0.sync_policy.0.retry.0.backoff.0.duration",
-						"30s",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.sync_policy",
-						"spec.0.sync_policy.0.retry.0.backoff.0.max_duration",
-						"2m",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.sync_policy",
-						"spec.0.sync_policy.0.retry.0.backoff.0.factor",
-						"2",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.sync_policy",
-						"spec.0.sync_policy.0.retry.0.limit",
-						"5",
+						"argocd_application.helm",
+						"spec.0.source.0.helm.0.ignore_missing_value_files",
+						"true",
 					),
 				),
 			},
 			{
-				ResourceName:            "argocd_application.sync_policy",
+				ResourceName:            "argocd_application.helm",
 				ImportState:             true,
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status"},
@@ -611,51 +648,47 @@ func TestAccArgoCDApplication_SyncPolicy(t *testing.T) {
 	})
 }
 
-func TestAccArgoCDApplication_NoSyncPolicyBlock(t *testing.T) {
+func TestAccArgoCDApplication_Helm_FileParameters(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationNoSyncPolicy(acctest.RandomWithPrefix("test-acc")),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.no_sync_policy",
-						"metadata.0.uid",
-					),
-					resource.TestCheckNoResourceAttr(
-						"argocd_application.no_sync_policy",
-						"spec.0.sync_policy.0.retry.0.backoff.0.duration",
-					),
-					resource.TestCheckNoResourceAttr(
-						"argocd_application.no_sync_policy",
-						"spec.0.sync_policy.0.automated.0.prune",
-					),
-				),
+				Config: testAccArgoCDApplicationHelm_FileParameters(acctest.RandomWithPrefix("test-acc")),
+				// Setting up tests for this is non-trivial so it is easier to test for an expected failure (since file does not exist) than to test for success
+				ExpectError: regexp.MustCompile(`(?s)Error: failed parsing.*--set-file data`),
 			},
 		},
 	})
 }
 
-func TestAccArgoCDApplication_EmptySyncPolicyBlock(t *testing.T) {
+func TestAccArgoCDApplication_Helm_FileParametersContent(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationEmptySyncPolicy(acctest.RandomWithPrefix("test-acc")),
+				Config:      testAccArgoCDApplicationHelm_FileParametersContent(name, `path = "foo.txt"`),
+				ExpectError: regexp.MustCompile("exactly one of `path` or `content` must be set"),
+			},
+			{
+				Config: testAccArgoCDApplicationHelm_FileParametersContent(name, ""),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(
-						"argocd_application.empty_sync_policy",
+						"argocd_application.helm_file_parameters_content",
 						"metadata.0.uid",
 					),
-					resource.TestCheckNoResourceAttr(
-						"argocd_application.empty_sync_policy",
-						"spec.0.sync_policy.0.retry.0.backoff.0.duration",
+					resource.TestCheckResourceAttr(
+						"argocd_application.helm_file_parameters_content",
+						"spec.0.source.0.helm.0.file_parameter.#",
+						"1",
 					),
-					resource.TestCheckNoResourceAttr(
-						"argocd_application.empty_sync_policy",
-						"spec.0.sync_policy.0.automated.0.prune",
+					resource.TestCheckResourceAttr(
+						"argocd_application.helm_file_parameters_content",
+						"spec.0.source.0.helm.0.parameter.#",
+						"0",
 					),
 				),
 			},
@@ -663,426 +696,1787 @@ func TestAccArgoCDApplication_EmptySyncPolicyBlock(t *testing.T) {
 	})
 }
 
-func TestAccArgoCDApplication_NoAutomatedBlock(t *testing.T) {
+func TestAccArgoCDApplication_Kustomize(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationNoAutomated(acctest.RandomWithPrefix("test-acc")),
+				Config: testAccArgoCDApplicationKustomize(
+					acctest.RandomWithPrefix("test-acc")),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(
-						"argocd_application.no_automated",
+						"argocd_application.kustomize",
 						"metadata.0.uid",
 					),
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.no_automated",
-						"spec.0.sync_policy.0.retry.0.backoff.0.duration",
+					resource.TestCheckResourceAttr(
+						"argocd_application.kustomize",
+						"spec.0.source.0.target_revision",
+						"release-kustomize-v3.7",
 					),
-					resource.TestCheckNoResourceAttr(
-						"argocd_application.no_automated",
-						"spec.0.sync_policy.0.automated.0.prune",
+					resource.TestCheckResourceAttr(
+						"argocd_application.kustomize",
+						"spec.0.source.0.kustomize.0.name_suffix",
+						"-bar",
 					),
 				),
 			},
+			{
+				ResourceName:            "argocd_application.kustomize",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status"},
+			},
 		},
 	})
 }
 
-func TestAccArgoCDApplication_EmptyAutomatedBlock(t *testing.T) {
+func TestAccArgoCDApplication_KustomizePatchesComponentsReplicas(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationEmptyAutomated(acctest.RandomWithPrefix("test-acc")),
+				Config: testAccArgoCDApplicationKustomizePatchesComponentsReplicas(name),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.empty_automated",
-						"metadata.0.uid",
+					resource.TestCheckResourceAttr(
+						"argocd_application."+name,
+						"spec.0.source.0.kustomize.0.patches.0.target.0.kind",
+						"Deployment",
 					),
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.empty_automated",
-						"spec.0.sync_policy.0.automated.#",
+					resource.TestCheckResourceAttr(
+						"argocd_application."+name,
+						"spec.0.source.0.kustomize.0.replicas.0.count",
+						"3",
 					),
-					resource.TestCheckNoResourceAttr(
-						"argocd_application.empty_automated",
-						"spec.0.sync_policy.0.automated.0.prune",
+					resource.TestCheckResourceAttr(
+						"argocd_application."+name,
+						"spec.0.source.0.kustomize.0.components.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application."+name,
+						"spec.0.source.0.kustomize.0.label_without_selector",
+						"true",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application."+name,
+						"spec.0.source.0.kustomize.0.namespace",
+						name,
 					),
 				),
 			},
+			{
+				ResourceName:            "argocd_application." + name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cascade", "validate", "metadata.0.generation", "metadata.0.resource_version", "status"},
+			},
 		},
 	})
 }
 
-func TestAccArgoCDApplication_OptionalPath(t *testing.T) {
-	app := acctest.RandomWithPrefix("test-acc")
-
+func TestAccArgoCDApplication_IgnoreDifferences(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationDirectoryNoPath(app),
+				Config: testAccArgoCDApplicationIgnoreDifferences(
+					acctest.RandomWithPrefix("test-acc")),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(
-						"argocd_application.directory",
+						"argocd_application.ignore_differences",
 						"metadata.0.uid",
 					),
 					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.path",
-						".",
-					),
-				),
-			},
-			{
-				Config: testAccArgoCDApplicationDirectoryPath(app, "."),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.directory",
-						"metadata.0.uid",
+						"argocd_application.ignore_differences",
+						"spec.0.ignore_difference.0.kind",
+						"Deployment",
 					),
 					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.path",
-						".",
+						"argocd_application.ignore_differences",
+						"spec.0.ignore_difference.1.group",
+						"apps",
 					),
 				),
 			},
 			{
-				Config: testAccArgoCDApplicationDirectoryNoPath(app),
+				ResourceName:            "argocd_application.ignore_differences",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "status"},
+			},
+			{
+				Config: testAccArgoCDApplicationIgnoreDiffJQPathExpressions(
+					acctest.RandomWithPrefix("test-acc")),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(
-						"argocd_application.directory",
+						"argocd_application.ignore_differences_jqpe",
 						"metadata.0.uid",
 					),
 					resource.TestCheckResourceAttr(
-						"argocd_application.directory",
-						"spec.0.source.0.path",
-						".",
+						"argocd_application.ignore_differences_jqpe",
+						"spec.0.ignore_difference.0.jq_path_expressions.0",
+						".spec.replicas",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.ignore_differences_jqpe",
+						"spec.0.ignore_difference.1.jq_path_expressions.1",
+						".spec.template.spec.metadata.labels.somelabel",
+					),
+					resource.TestCheckTypeSetElemAttr(
+						"argocd_application.ignore_differences_jqpe",
+						"spec.0.ignore_difference.0.managed_fields_managers.*",
+						"kube-controller-manager",
 					),
 				),
 			},
+			{
+				ResourceName:            "argocd_application.ignore_differences_jqpe",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "status"},
+			},
 		},
 	})
 }
 
-func TestAccArgoCDApplication_Info(t *testing.T) {
-	name := acctest.RandomWithPrefix("test-acc-info")
-	info := acctest.RandString(8)
-	value := acctest.RandString(8)
+func TestAccArgoCDApplication_RevisionHistoryLimit(t *testing.T) {
+	revisionHistoryLimit := acctest.RandIntRange(0, 9)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationInfo(name, info, value),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.info",
-						"metadata.0.uid",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.info",
-						"spec.0.info.0.name",
-						info,
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.info",
-						"spec.0.info.0.value",
-						value,
-					),
+				Config: testAccArgoCDApplicationRevisionHistory(
+					acctest.RandomWithPrefix("test-acc"),
+					revisionHistoryLimit,
 				),
-			},
-			{
-				Config: testAccArgoCDApplicationInfoNoName(name, value),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(
-						"argocd_application.info",
+						"argocd_application.revision_history_limit",
 						"metadata.0.uid",
 					),
 					resource.TestCheckResourceAttr(
-						"argocd_application.info",
-						"spec.0.info.0.name",
-						"",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.info",
-						"spec.0.info.0.value",
-						value,
+						"argocd_application.revision_history_limit",
+						"spec.0.revision_history_limit",
+						fmt.Sprint(revisionHistoryLimit),
 					),
 				),
 			},
 			{
-				Config: testAccArgoCDApplicationInfoNoValue(name, info),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.info",
-						"metadata.0.uid",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.info",
-						"spec.0.info.0.name",
-						info,
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.info",
-						"spec.0.info.0.value",
-						"",
-					),
-				),
+				ResourceName:            "argocd_application.revision_history_limit",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "status"},
 			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_OptionalDestinationNamespace(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationInfo(name, info, value),
+				Config: testAccArgoCDApplication_OptionalDestinationNamespace(acctest.RandomWithPrefix("test-acc")),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(
-						"argocd_application.info",
+						"argocd_application.no_namespace",
 						"metadata.0.uid",
 					),
 					resource.TestCheckResourceAttr(
-						"argocd_application.info",
-						"spec.0.info.0.name",
-						info,
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.info",
-						"spec.0.info.0.value",
-						value,
-					),
-				),
-			},
-			{
-				Config: testAccArgoCDApplicationNoInfo(name),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.info",
-						"metadata.0.uid",
-					),
-					resource.TestCheckNoResourceAttr(
-						"argocd_application.info",
-						"spec.0.info.0",
+						"argocd_application.no_namespace",
+						"spec.0.destination.0.namespace",
+						"", // optional strings are maintained in state as blank strings
 					),
 				),
 			},
 			{
-				Config:      testAccArgoCDApplicationInfoEmpty(name),
-				ExpectError: regexp.MustCompile("info: cannot be empty."),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.info",
-						"metadata.0.uid",
-					),
-					resource.TestCheckNoResourceAttr(
-						"argocd_application.info",
-						"spec.0.info.0",
-					),
-				),
+				ResourceName:            "argocd_application.no_namespace",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "status"},
 			},
 		},
 	})
 }
 
-func TestAccArgoCDApplication_SkipCrds(t *testing.T) {
-	name := acctest.RandomWithPrefix("test-acc-crds")
-
+func TestAccArgoCDApplication_DirectoryJsonnet(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationSkipCrds_NoSkip(name),
+				Config: testAccArgoCDApplication_DirectoryJsonnet(
+					acctest.RandomWithPrefix("test-acc")),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(
-						"argocd_application.crds",
+						"argocd_application.directory",
 						"metadata.0.uid",
 					),
 					resource.TestCheckResourceAttr(
-						"argocd_application.crds",
-						"spec.0.source.0.helm.0.skip_crds",
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.recurse",
 						"false",
 					),
-				),
-			},
-			{
-				Config: testAccArgoCDApplicationSkipCrds(name, true),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.crds",
-						"metadata.0.uid",
-					),
 					resource.TestCheckResourceAttr(
-						"argocd_application.crds",
-						"spec.0.source.0.helm.0.skip_crds",
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.jsonnet.0.ext_var.0.name",
+						"somename",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.jsonnet.0.ext_var.0.value",
+						"somevalue",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.jsonnet.0.ext_var.0.code",
+						"false",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.jsonnet.0.ext_var.1.name",
+						"anothername",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.jsonnet.0.ext_var.1.value",
+						"anothervalue",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.jsonnet.0.ext_var.1.code",
+						"true",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.jsonnet.0.tla.0.name",
+						"yetanothername",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.jsonnet.0.tla.0.value",
+						"yetanothervalue",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.jsonnet.0.tla.0.code",
 						"true",
 					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.jsonnet.0.libs.0",
+						"vendor",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.jsonnet.0.libs.1",
+						"foo",
+					),
 				),
 			},
 			{
-				Config: testAccArgoCDApplicationSkipCrds(name, false),
+				ResourceName:            "argocd_application.directory",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status"},
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_Recurse(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationRecurseDirectory(name, true),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.crds",
-						"metadata.0.uid",
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.recurse",
+						"true",
+					),
+					resource.TestCheckNoResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.jsonnet.0",
 					),
+				),
+			},
+			{
+				Config: testAccArgoCDApplicationDirectoryImplicitNonRecurse(acctest.RandomWithPrefix("test-acc")),
+				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
-						"argocd_application.crds",
-						"spec.0.source.0.helm.0.skip_crds",
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.recurse",
 						"false",
 					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.jsonnet.0.ext_var.0.name",
+						"somename",
+					),
+				),
+			},
+			{
+				Config: testAccArgoCDApplicationRecurseDirectory(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.recurse",
+					),
+					resource.TestCheckNoResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.jsonnet.0",
+					),
+				),
+			},
+			{
+				Config: testAccArgoCDApplicationRecurseDirectory(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.recurse",
+						"true",
+					),
+					resource.TestCheckNoResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.jsonnet.0",
+					),
+				),
+			},
+			{
+				Config: testAccArgoCDApplicationRecurseDirectory(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.recurse",
+					),
+					resource.TestCheckNoResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.jsonnet.0",
+					),
 				),
 			},
 		},
 	})
 }
 
-func TestAccArgoCDApplication_CustomNamespace(t *testing.T) {
+func TestAccArgoCDApplication_EmptyDirectory(t *testing.T) {
 	name := acctest.RandomWithPrefix("test-acc")
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ProjectSourceNamespaces) },
+		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDApplicationCustomNamespace(name),
+				Config: testAccArgoCDApplication_EmptyDirectory(name),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.custom_namespace",
-						"metadata.0.uid",
+					resource.TestCheckNoResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.recurse",
 					),
 				),
 			},
 			{
-				ResourceName:            "argocd_application.custom_namespace",
+				ResourceName:            "argocd_application.directory",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "status"},
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status"},
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_DirectoryIncludeExclude(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplication_DirectoryIncludeExclude(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.include",
+						"*.yaml",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.exclude",
+						"config.yaml",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application.directory",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status"},
+			},
+			{
+				Config: testAccArgoCDApplication_DirectoryIncludeExcludeOnly(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.include",
+						"*.yaml",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.directory.0.exclude",
+						"{tests/*,docs/*}",
+					),
+				),
+			},
+			{
+				Config:             testAccArgoCDApplication_DirectoryIncludeExcludeOnly(name),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_PluginParameters(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationPluginParameters(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_application."+name,
+						"spec.0.source.0.plugin.0.parameter.0.string",
+						"bar",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application."+name,
+						"spec.0.source.0.plugin.0.parameter.1.map.key",
+						"value",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application."+name,
+						"spec.0.source.0.plugin.0.parameter.2.array.1",
+						"b",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application." + name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cascade", "validate", "metadata.0.generation", "metadata.0.resource_version", "status"},
 			},
 		},
 	})
 }
 
-func TestAccArgoCDApplication_MultipleSources(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.MultipleApplicationSources) },
-		ProviderFactories: testAccProviders,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccArgoCDApplicationMultipleSources(),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.multiple_sources",
-						"metadata.0.uid",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.multiple_sources",
-						"spec.0.source.0.chart",
-						"elasticsearch",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.multiple_sources",
-						"spec.0.source.1.path",
-						"guestbook",
-					),
-				),
-			},
-			{
-				ResourceName:            "argocd_application.multiple_sources",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status"},
-			},
-		},
-	})
+func TestAccArgoCDApplication_SyncPolicy(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationSyncPolicy(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.sync_policy",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.sync_policy",
+						"spec.0.sync_policy.0.automated.0.prune",
+						"true",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.sync_policy",
+						"spec.0.sync_policy.0.automated.0.self_heal",
+						"true",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.sync_policy",
+						"spec.0.sync_policy.0.automated.0.allow_empty",
+						"true",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.sync_policy",
+						"spec.0.sync_policy.0.retry.0.backoff.0.duration",
+						"30s",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.sync_policy",
+						"spec.0.sync_policy.0.retry.0.backoff.0.max_duration",
+						"2m",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.sync_policy",
+						"spec.0.sync_policy.0.retry.0.backoff.0.factor",
+						"2",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.sync_policy",
+						"spec.0.sync_policy.0.retry.0.limit",
+						"5",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application.sync_policy",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status"},
+			},
+			{
+				Config: testAccArgoCDApplicationSyncPolicyAutomatedEmpty(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_application.sync_policy",
+						"spec.0.sync_policy.0.automated.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.sync_policy",
+						"spec.0.sync_policy.0.automated.0.prune",
+						"false",
+					),
+				),
+			},
+			{
+				Config:             testAccArgoCDApplicationSyncPolicyAutomatedEmpty(name),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				Config: testAccArgoCDApplicationSyncPolicyAllowEmptyWithoutPrune(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_application.sync_policy",
+						"spec.0.sync_policy.0.automated.0.allow_empty",
+						"true",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.sync_policy",
+						"spec.0.sync_policy.0.automated.0.prune",
+						"false",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_SyncOptions(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDApplicationSyncOptions(name, `sync_options = ["ServerSideApply=yes"]`),
+				ExpectError: regexp.MustCompile("invalid value 'yes' for sync option 'ServerSideApply'"),
+			},
+			{
+				Config: testAccArgoCDApplicationSyncOptions(name, `sync_options = ["PrunePropagationPolicy=foreground"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_application."+name,
+						"spec.0.sync_policy.0.options.0.create_namespace",
+						"true",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application."+name,
+						"spec.0.sync_policy.0.options.0.server_side_apply",
+						"true",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application."+name,
+						"spec.0.sync_policy.0.sync_options.#",
+						"1",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_NoSyncPolicyBlock(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationNoSyncPolicy(acctest.RandomWithPrefix("test-acc")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.no_sync_policy",
+						"metadata.0.uid",
+					),
+					resource.TestCheckNoResourceAttr(
+						"argocd_application.no_sync_policy",
+						"spec.0.sync_policy.0.retry.0.backoff.0.duration",
+					),
+					resource.TestCheckNoResourceAttr(
+						"argocd_application.no_sync_policy",
+						"spec.0.sync_policy.0.automated.0.prune",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_EmptySyncPolicyBlock(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationEmptySyncPolicy(acctest.RandomWithPrefix("test-acc")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.empty_sync_policy",
+						"metadata.0.uid",
+					),
+					resource.TestCheckNoResourceAttr(
+						"argocd_application.empty_sync_policy",
+						"spec.0.sync_policy.0.retry.0.backoff.0.duration",
+					),
+					resource.TestCheckNoResourceAttr(
+						"argocd_application.empty_sync_policy",
+						"spec.0.sync_policy.0.automated.0.prune",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_NoAutomatedBlock(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationNoAutomated(acctest.RandomWithPrefix("test-acc")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.no_automated",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.no_automated",
+						"spec.0.sync_policy.0.retry.0.backoff.0.duration",
+					),
+					resource.TestCheckNoResourceAttr(
+						"argocd_application.no_automated",
+						"spec.0.sync_policy.0.automated.0.prune",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_EmptyAutomatedBlock(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationEmptyAutomated(acctest.RandomWithPrefix("test-acc")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.empty_automated",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.empty_automated",
+						"spec.0.sync_policy.0.automated.#",
+					),
+					resource.TestCheckNoResourceAttr(
+						"argocd_application.empty_automated",
+						"spec.0.sync_policy.0.automated.0.prune",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_OptionalPath(t *testing.T) {
+	app := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationDirectoryNoPath(app),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.directory",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.path",
+						".",
+					),
+				),
+			},
+			{
+				Config: testAccArgoCDApplicationDirectoryPath(app, "."),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.directory",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.path",
+						".",
+					),
+				),
+			},
+			{
+				Config: testAccArgoCDApplicationDirectoryNoPath(app),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.directory",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.directory",
+						"spec.0.source.0.path",
+						".",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_Info(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc-info")
+	info := acctest.RandString(8)
+	value := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationInfo(name, info, value),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.info",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.info",
+						"spec.0.info.0.name",
+						info,
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.info",
+						"spec.0.info.0.value",
+						value,
+					),
+				),
+			},
+			{
+				Config: testAccArgoCDApplicationInfoNoName(name, value),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.info",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.info",
+						"spec.0.info.0.name",
+						"",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.info",
+						"spec.0.info.0.value",
+						value,
+					),
+				),
+			},
+			{
+				Config: testAccArgoCDApplicationInfoNoValue(name, info),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.info",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.info",
+						"spec.0.info.0.name",
+						info,
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.info",
+						"spec.0.info.0.value",
+						"",
+					),
+				),
+			},
+			{
+				Config: testAccArgoCDApplicationInfo(name, info, value),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.info",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.info",
+						"spec.0.info.0.name",
+						info,
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.info",
+						"spec.0.info.0.value",
+						value,
+					),
+				),
+			},
+			{
+				Config: testAccArgoCDApplicationNoInfo(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.info",
+						"metadata.0.uid",
+					),
+					resource.TestCheckNoResourceAttr(
+						"argocd_application.info",
+						"spec.0.info.0",
+					),
+				),
+			},
+			{
+				Config:      testAccArgoCDApplicationInfoEmpty(name),
+				ExpectError: regexp.MustCompile("info: cannot be empty."),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.info",
+						"metadata.0.uid",
+					),
+					resource.TestCheckNoResourceAttr(
+						"argocd_application.info",
+						"spec.0.info.0",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_SkipCrds(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc-crds")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationSkipCrds_NoSkip(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.crds",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.crds",
+						"spec.0.source.0.helm.0.skip_crds",
+						"false",
+					),
+				),
+			},
+			{
+				Config: testAccArgoCDApplicationSkipCrds(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.crds",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.crds",
+						"spec.0.source.0.helm.0.skip_crds",
+						"true",
+					),
+				),
+			},
+			{
+				Config: testAccArgoCDApplicationSkipCrds(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.crds",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.crds",
+						"spec.0.source.0.helm.0.skip_crds",
+						"false",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_CustomNamespace(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ProjectSourceNamespaces) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationCustomNamespace(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.custom_namespace",
+						"metadata.0.uid",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application.custom_namespace",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "status"},
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_MultipleSources(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.MultipleApplicationSources) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationMultipleSources(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.multiple_sources",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.multiple_sources",
+						"spec.0.source.0.chart",
+						"elasticsearch",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.multiple_sources",
+						"spec.0.source.1.path",
+						"guestbook",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application.multiple_sources",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status"},
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_HelmValuesFromExternalGitRepo(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.MultipleApplicationSources) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationHelmValuesFromExternalGitRepo(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.helm_values_external",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.helm_values_external",
+						"spec.0.source.0.chart",
+						"wordpress",
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_application.helm_values_external",
+						"spec.0.source.0.helm.0.value_files.#",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application.helm_values_external",
+						"spec.0.source.1.ref",
+						"values",
+					),
+				),
+			},
+			{
+				ResourceName:            "argocd_application.helm_values_external",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status"},
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_HelmValuesFromMissingRef(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDApplicationHelmValuesFromMissingRef(),
+				ExpectError: regexp.MustCompile(`no source with ref = "missing" exists`),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_ManagedNamespaceMetadata(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ManagedNamespaceMetadata) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplication_ManagedNamespaceMetadata(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("argocd_application.namespace_metadata", "metadata.0.uid"),
+					resource.TestCheckResourceAttrSet("argocd_application.namespace_metadata", "spec.0.sync_policy.0.managed_namespace_metadata.0.annotations.%"),
+					resource.TestCheckResourceAttrSet("argocd_application.namespace_metadata", "spec.0.sync_policy.0.managed_namespace_metadata.0.labels.%"),
+				),
+			},
+			{
+				ResourceName:            "argocd_application.namespace_metadata",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_Wait(t *testing.T) {
+	chartRevision := "9.4.1"
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationSimple(name, chartRevision, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_application."+name,
+						"wait",
+						"true",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application."+name,
+						"spec.0.source.0.target_revision",
+						chartRevision,
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_Validate(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDApplicationValidate(name, true),
+				ExpectError: regexp.MustCompile("application spec for .* is invalid"),
+			},
+			{
+				Config: testAccArgoCDApplicationValidate(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_application."+name,
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_application."+name,
+						"validate",
+						"false",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplication_ProjectRestrictions(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// The project must exist for the application to be validated
+				// against it at plan time
+				Config: testAccArgoCDApplicationProjectRestrictions(name, false, "", ""),
+			},
+			{
+				Config:      testAccArgoCDApplicationProjectRestrictions(name, true, "https://github.com/argoproj/argo-cd.git", name),
+				ExpectError: regexp.MustCompile("application will be rejected: repository https://github.com/argoproj/argo-cd.git is not permitted in project " + name),
+			},
+			{
+				Config:      testAccArgoCDApplicationProjectRestrictions(name, true, "https://raw.githubusercontent.com/bitnami/charts/archive-full-index/bitnami", "kube-system"),
+				ExpectError: regexp.MustCompile("application will be rejected: destination .* do not match any of the allowed destinations in project " + name),
+			},
+			{
+				Config:      testAccArgoCDApplicationProjectRestrictionsCreateNamespace(name),
+				ExpectError: regexp.MustCompile("application will fail to sync: cluster resource Namespace is not permitted in project " + name),
+			},
+			{
+				Config: testAccArgoCDApplicationProjectRestrictions(name, true, "https://raw.githubusercontent.com/bitnami/charts/archive-full-index/bitnami", name),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_application."+name,
+					"spec.0.project",
+					name,
+				),
+			},
+		},
+	})
+}
+
+func testAccArgoCDApplicationProjectRestrictions(name string, withApplication bool, repoURL, namespace string) string {
+	config := fmt.Sprintf(`
+resource "argocd_project" "%[1]s" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source_repos = ["https://raw.githubusercontent.com/bitnami/charts/archive-full-index/bitnami"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+  }
+}
+	`, name)
+
+	if !withApplication {
+		return config
+	}
+
+	return config + fmt.Sprintf(`
+resource "argocd_application" "%[1]s" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    project = argocd_project.%[1]s.metadata[0].name
+
+    source {
+      repo_url        = "%[2]s"
+      chart           = "apache"
+      target_revision = "9.4.1"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[3]s"
+    }
+  }
+}
+	`, name, repoURL, namespace)
+}
+
+func testAccArgoCDApplicationProjectRestrictionsCreateNamespace(name string) string {
+	return testAccArgoCDApplicationProjectRestrictions(name, false, "", "") + fmt.Sprintf(`
+resource "argocd_application" "%[1]s" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    project = argocd_project.%[1]s.metadata[0].name
+
+    source {
+      repo_url        = "https://raw.githubusercontent.com/bitnami/charts/archive-full-index/bitnami"
+      chart           = "apache"
+      target_revision = "9.4.1"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+
+    sync_policy {
+      sync_options = ["CreateNamespace=true"]
+    }
+  }
+}
+	`, name)
+}
+
+func testAccArgoCDApplicationWaitFor(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "wait_for" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source {
+      repo_url        = "https://raw.githubusercontent.com/bitnami/charts/archive-full-index/bitnami"
+      chart           = "apache"
+      target_revision = "9.4.1"
+    }
+
+    sync_policy {
+      automated {
+        prune     = true
+        self_heal = true
+      }
+      sync_options = ["CreateNamespace=true"]
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+  }
+
+  wait = true
+
+  wait_for {
+    kind = "Deployment"
+  }
+}
+	`, name)
+}
+
+func testAccArgoCDApplicationWaitForInvalidHealth(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "wait_for" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source {
+      repo_url        = "https://raw.githubusercontent.com/bitnami/charts/archive-full-index/bitnami"
+      chart           = "apache"
+      target_revision = "9.4.1"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+  }
+
+  wait = true
+
+  wait_for {
+    kind   = "Deployment"
+    health = "Green"
+  }
+}
+	`, name)
+}
+
+func testAccArgoCDApplicationRefreshOnRead(name, refresh string) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "refresh" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  refresh_on_read = "%[2]s"
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "guestbook"
+      target_revision = "HEAD"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+  }
+}
+	`, name, refresh)
+}
+
+func testAccArgoCDApplicationDriftDetection(name, mode string) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "drift" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  drift_detection = "%[2]s"
+  wait            = true
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "guestbook"
+      target_revision = "HEAD"
+    }
+
+    sync_policy {
+      automated {
+        prune     = true
+        self_heal = true
+      }
+      sync_options = ["CreateNamespace=true"]
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+  }
+}
+	`, name, mode)
+}
+
+func testAccArgoCDApplicationTerminateOperationOnTimeout(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "terminate" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  terminate_operation_on_timeout = true
+  wait                           = true
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "guestbook"
+      target_revision = "HEAD"
+    }
+
+    sync_policy {
+      automated {}
+      sync_options = ["CreateNamespace=true"]
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+  }
+
+  timeouts {
+    create = "2m"
+    delete = "2m"
+  }
+}
+	`, name)
+}
+
+func testAccArgoCDApplicationWaitForApplications(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "dependency" {
+  metadata {
+    name      = "%[1]s-dependency"
+    namespace = "argocd"
+  }
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "guestbook"
+      target_revision = "HEAD"
+    }
+
+    sync_policy {
+      automated {}
+      sync_options = ["CreateNamespace=true"]
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s-dependency"
+    }
+  }
+}
+
+resource "argocd_application" "dependent" {
+  metadata {
+    name      = "%[1]s-dependent"
+    namespace = "argocd"
+  }
+
+  wait_for_applications = [
+    argocd_application.dependency.id,
+    "argocd/%[1]s-dependency",
+  ]
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "guestbook"
+      target_revision = "HEAD"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s-dependent"
+    }
+  }
+}
+	`, name)
+}
+
+func testAccArgoCDApplicationWaitForApplicationsInvalid(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "invalid" {
+  metadata {
+    name      = "%[1]s-invalid"
+    namespace = "argocd"
+  }
+
+  wait_for_applications = ["https://argocd.example.com/applications/argocd/app"]
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "guestbook"
+      target_revision = "HEAD"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+  }
+}
+	`, name)
+}
+
+func testAccArgoCDApplicationDeletionFinalizer(name, finalizer string) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "finalizer" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  deletion_finalizer = "%[2]s"
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "guestbook"
+      target_revision = "HEAD"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+  }
+}
+	`, name, finalizer)
+}
+
+func testAccArgoCDApplicationForceDeleteAfter(name, after string) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "force_delete" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  cascade            = true
+  deletion_finalizer = "foreground"
+  force_delete_after = "%[2]s"
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "guestbook"
+      target_revision = "HEAD"
+    }
+
+    sync_policy {
+      automated {}
+      sync_options = ["CreateNamespace=true"]
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+  }
+
+  timeouts {
+    delete = "3m"
+  }
+}
+	`, name, after)
+}
+
+func testAccArgoCDApplicationApplicationSetOwned(name string, withApplication bool) string {
+	config := fmt.Sprintf(`
+resource "argocd_application_set" "owner" {
+  metadata {
+    name = "%[1]s"
+  }
+
+  spec {
+    generator {
+      list {
+        elements = [
+          {
+            cluster = "in-cluster"
+          }
+        ]
+      }
+    }
+
+    template {
+      metadata {
+        name = "%[1]s"
+      }
+
+      spec {
+        source {
+          repo_url        = "https://github.com/argoproj/argocd-example-apps"
+          path            = "guestbook"
+          target_revision = "HEAD"
+        }
+
+        destination {
+          server    = "https://kubernetes.default.svc"
+          namespace = "%[1]s"
+        }
+      }
+    }
+  }
+}
+	`, name)
+
+	if !withApplication {
+		return config
+	}
+
+	return config + fmt.Sprintf(`
+resource "argocd_application" "owned" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "guestbook"
+      target_revision = "HEAD"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+  }
+}
+	`, name)
+}
+
+func testAccArgoCDApplicationInitialSync(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "initial_sync" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  wait = true
+
+  initial_sync {
+    prune        = true
+    strategy     = "apply"
+    sync_options = ["CreateNamespace=true"]
+  }
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "guestbook"
+      target_revision = "HEAD"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+  }
+}
+	`, name)
+}
+
+func testAccArgoCDApplicationFailOnConditionTypes(name, path, conditionTypes string) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "conditions" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  validate                = false
+  fail_on_condition_types = %[3]s
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "%[2]s"
+      target_revision = "HEAD"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+  }
+}
+	`, name, path, conditionTypes)
 }
 
-func TestAccArgoCDApplication_HelmValuesFromExternalGitRepo(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.MultipleApplicationSources) },
-		ProviderFactories: testAccProviders,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccArgoCDApplicationHelmValuesFromExternalGitRepo(),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.helm_values_external",
-						"metadata.0.uid",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.helm_values_external",
-						"spec.0.source.0.chart",
-						"wordpress",
-					),
-					resource.TestCheckResourceAttrSet(
-						"argocd_application.helm_values_external",
-						"spec.0.source.0.helm.0.value_files.#",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application.helm_values_external",
-						"spec.0.source.1.ref",
-						"values",
-					),
-				),
-			},
-			{
-				ResourceName:            "argocd_application.helm_values_external",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version", "status"},
-			},
-		},
-	})
+func testAccArgoCDApplicationServerDefaults(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "defaults" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "helm-guestbook"
+      target_revision = "HEAD"
+
+      helm {
+        values = <<EOT
+replicaCount: 1
+
+EOT
+      }
+    }
+
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "guestbook"
+      target_revision = "HEAD"
+
+      kustomize {}
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+
+    sync_policy {
+      sync_options = ["Validate=false", "CreateNamespace=true", "ApplyOutOfSyncOnly=true"]
+    }
+  }
+}
+	`, name)
 }
 
-func TestAccArgoCDApplication_ManagedNamespaceMetadata(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckFeatureSupported(t, features.ManagedNamespaceMetadata) },
-		ProviderFactories: testAccProviders,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccArgoCDApplication_ManagedNamespaceMetadata(),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet("argocd_application.namespace_metadata", "metadata.0.uid"),
-					resource.TestCheckResourceAttrSet("argocd_application.namespace_metadata", "spec.0.sync_policy.0.managed_namespace_metadata.0.annotations.%"),
-					resource.TestCheckResourceAttrSet("argocd_application.namespace_metadata", "spec.0.sync_policy.0.managed_namespace_metadata.0.labels.%"),
-				),
-			},
-			{
-				ResourceName:            "argocd_application.namespace_metadata",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait", "cascade", "metadata.0.generation", "metadata.0.resource_version"},
-			},
-		},
-	})
+func testAccArgoCDApplicationDeletionProtection(name string, protected bool) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "protected" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  deletion_protection = %[2]t
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "guestbook"
+      target_revision = "HEAD"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+  }
+}
+	`, name, protected)
 }
 
-func TestAccArgoCDApplication_Wait(t *testing.T) {
-	chartRevision := "9.4.1"
-	name := acctest.RandomWithPrefix("test-acc")
+func testAccArgoCDApplicationPaused(name string, paused bool) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "paused" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviders,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccArgoCDApplicationSimple(name, chartRevision, true),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr(
-						"argocd_application."+name,
-						"wait",
-						"true",
-					),
-					resource.TestCheckResourceAttr(
-						"argocd_application."+name,
-						"spec.0.source.0.target_revision",
-						chartRevision,
-					),
-				),
-			},
-		},
-	})
+  paused = %[2]t
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "guestbook"
+      target_revision = "HEAD"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+  }
+}
+	`, name, paused)
 }
 
 func testAccArgoCDApplicationSimple(name, targetRevision string, wait bool) string {
@@ -1219,6 +2613,42 @@ resource "argocd_application" "helm_file_parameters" {
 }`, name)
 }
 
+func testAccArgoCDApplicationHelm_FileParametersContent(name, extra string) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "helm_file_parameters_content" {
+	metadata {
+		name      = "%[1]s"
+		namespace = "argocd"
+	}
+
+	spec {
+		source {
+			repo_url        = "https://raw.githubusercontent.com/bitnami/charts/archive-full-index/bitnami"
+			chart           = "redis"
+			target_revision = "16.9.11"
+
+			helm {
+				release_name = "testing"
+				file_parameter {
+					name    = "commonAnnotations.foo"
+					content = "bar"
+					%[2]s
+				}
+			}
+		}
+
+		sync_policy {
+			sync_options = ["CreateNamespace=true"]
+		}
+
+		destination {
+			server    = "https://kubernetes.default.svc"
+			namespace = "%[1]s"
+		}
+	}
+}`, name, extra)
+}
+
 func testAccArgoCDApplicationKustomize(name string) string {
 	return fmt.Sprintf(`
 resource "argocd_application" "kustomize" {
@@ -1254,9 +2684,61 @@ resource "argocd_application" "kustomize" {
 
     destination {
       server    = "https://kubernetes.default.svc"
-      namespace = "default"
+      namespace = "default"
+    }
+  }
+}
+	`, name)
+}
+
+func testAccArgoCDApplicationKustomizePatchesComponentsReplicas(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "%[1]s" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "kustomize-guestbook"
+      target_revision = "HEAD"
+      kustomize {
+        namespace              = "%[1]s"
+        common_labels_force    = true
+        label_without_selector = true
+        common_labels = {
+          "app.kubernetes.io/part-of" = "guestbook"
+        }
+        components = ["../components/extra"]
+
+        patches {
+          patch = <<-EOT
+            - op: replace
+              path: /spec/template/spec/containers/0/ports/0/containerPort
+              value: 443
+          EOT
+          target {
+            kind = "Deployment"
+            name = "kustomize-guestbook-ui"
+          }
+        }
+
+        replicas {
+          name  = "kustomize-guestbook-ui"
+          count = "3"
+        }
+      }
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
     }
   }
+
+  validate = false
 }
 	`, name)
 }
@@ -1501,6 +2983,79 @@ resource "argocd_application" "directory" {
 	`, name)
 }
 
+func testAccArgoCDApplication_DirectoryIncludeExcludeOnly(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "directory" {
+  metadata {
+    name      = "%s"
+    namespace = "argocd"
+    labels = {
+      acceptance = "true"
+    }
+  }
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "guestbook"
+      target_revision = "HEAD"
+      directory {
+        include = "*.yaml"
+        exclude = "{tests/*,docs/*}"
+      }
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+  }
+}
+	`, name)
+}
+
+func testAccArgoCDApplicationPluginParameters(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "%[1]s" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "plugins/kustomized-helm"
+      target_revision = "HEAD"
+      plugin {
+        parameter {
+          name   = "foo"
+          string = "bar"
+        }
+        parameter {
+          name = "labels"
+          map = {
+            key = "value"
+          }
+        }
+        parameter {
+          name  = "values"
+          array = ["a", "b"]
+        }
+      }
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+  }
+
+  validate = false
+}
+	`, name)
+}
+
 func testAccArgoCDApplicationSyncPolicy(name string) string {
 	return fmt.Sprintf(`
 resource "argocd_application" "sync_policy" {
@@ -1544,6 +3099,39 @@ resource "argocd_application" "sync_policy" {
 	`, name)
 }
 
+func testAccArgoCDApplicationSyncOptions(name, syncOptions string) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "%[1]s" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source {
+      repo_url        = "https://raw.githubusercontent.com/bitnami/charts/archive-full-index/bitnami"
+      chart           = "redis"
+      target_revision = "16.9.11"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+
+    sync_policy {
+      %[2]s
+
+      options {
+        create_namespace  = true
+        server_side_apply = true
+      }
+    }
+  }
+}
+	`, name, syncOptions)
+}
+
 func testAccArgoCDApplicationIgnoreDifferences(name string) string {
 	return fmt.Sprintf(`
 resource "argocd_application" "ignore_differences" {
@@ -1611,9 +3199,10 @@ resource "argocd_application" "ignore_differences_jqpe" {
     }
     
     ignore_difference {
-      group               = "apps"
-      kind                = "Deployment"
-      jq_path_expressions = [".spec.replicas"]
+      group                   = "apps"
+      kind                    = "Deployment"
+      jq_path_expressions     = [".spec.replicas"]
+      managed_fields_managers = ["kube-controller-manager"]
     }
 
     ignore_difference {
@@ -2118,6 +3707,64 @@ resource "argocd_application" "multiple_sources" {
 }`
 }
 
+func testAccArgoCDApplicationSyncPolicyAutomatedEmpty(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "sync_policy" {
+  metadata {
+    name      = "%s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source {
+      repo_url        = "https://raw.githubusercontent.com/bitnami/charts/archive-full-index/bitnami"
+      chart           = "redis"
+      target_revision = "16.9.11"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+
+    sync_policy {
+      automated {}
+    }
+  }
+}
+	`, name)
+}
+
+func testAccArgoCDApplicationSyncPolicyAllowEmptyWithoutPrune(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "sync_policy" {
+  metadata {
+    name      = "%s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source {
+      repo_url        = "https://raw.githubusercontent.com/bitnami/charts/archive-full-index/bitnami"
+      chart           = "redis"
+      target_revision = "16.9.11"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+
+    sync_policy {
+      automated {
+        allow_empty = true
+      }
+    }
+  }
+}
+	`, name)
+}
+
 func testAccArgoCDApplication_ManagedNamespaceMetadata() string {
 	return `
 resource "argocd_application" "namespace_metadata" {
@@ -2188,3 +3835,240 @@ resource "argocd_application" "helm_values_external" {
   }
 }`
 }
+
+func testAccArgoCDApplicationValidate(name string, validate bool) string {
+	return fmt.Sprintf(`
+resource "argocd_application" "%[1]s" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  validate = %[2]t
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps"
+      path            = "this/path/does/not/exist"
+      target_revision = "HEAD"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "%[1]s"
+    }
+  }
+}
+	`, name, validate)
+}
+
+func testAccArgoCDApplicationHelmValuesFromMissingRef() string {
+	return `
+resource "argocd_application" "helm_values_missing_ref" {
+  metadata {
+    name      = "helm-values-missing-ref"
+    namespace = "argocd"
+  }
+
+  spec {
+    source {
+      repo_url        = "https://charts.helm.sh/stable"
+      chart           = "wordpress"
+      target_revision = "9.0.3"
+      helm {
+        value_files = ["$missing/helm-dependency/values.yaml"]
+      }
+    }
+
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps.git"
+      target_revision = "HEAD"
+      ref             = "values"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+  }
+}`
+}
+
+func Test_parseApplicationImportID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		id            string
+		wantName      string
+		wantNamespace string
+		wantErr       bool
+	}{
+		{
+			name:          "Name and namespace",
+			id:            "guestbook:argocd",
+			wantName:      "guestbook",
+			wantNamespace: "argocd",
+		},
+		{
+			name:          "Namespace and name",
+			id:            "argocd/guestbook",
+			wantName:      "guestbook",
+			wantNamespace: "argocd",
+		},
+		{
+			name:     "Bare name",
+			id:       "guestbook",
+			wantName: "guestbook",
+		},
+		{
+			name:          "UI URL",
+			id:            "https://argocd.example.com/applications/argocd/guestbook?view=tree",
+			wantName:      "guestbook",
+			wantNamespace: "argocd",
+		},
+		{
+			name:     "Legacy UI URL",
+			id:       "https://argocd.example.com/applications/guestbook",
+			wantName: "guestbook",
+		},
+		{
+			name:    "Missing name",
+			id:      "argocd/",
+			wantErr: true,
+		},
+		{
+			name:    "Too many separators",
+			id:      "guestbook:argocd:extra",
+			wantErr: true,
+		},
+		{
+			name:    "URL without application",
+			id:      "https://argocd.example.com/settings",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotName, gotNamespace, err := parseApplicationImportID(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseApplicationImportID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if gotName != tt.wantName || gotNamespace != tt.wantNamespace {
+				t.Errorf("parseApplicationImportID() = %s, %s, want %s, %s", gotName, gotNamespace, tt.wantName, tt.wantNamespace)
+			}
+		})
+	}
+}
+
+func Test_parseNamespacedImportID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		id            string
+		wantName      string
+		wantNamespace string
+		wantErr       bool
+	}{
+		{
+			name:          "Name and namespace",
+			id:            "appset:mynamespace-1",
+			wantName:      "appset",
+			wantNamespace: "mynamespace-1",
+		},
+		{
+			name:          "Namespace and name",
+			id:            "mynamespace-1/appset",
+			wantName:      "appset",
+			wantNamespace: "mynamespace-1",
+		},
+		{
+			name:     "Bare name",
+			id:       "appset",
+			wantName: "appset",
+		},
+		{
+			name:    "Missing name",
+			id:      "mynamespace-1/",
+			wantErr: true,
+		},
+		{
+			name:    "Missing namespace",
+			id:      "appset:",
+			wantErr: true,
+		},
+		{
+			name:    "Too many separators",
+			id:      "mynamespace-1/appset/extra",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotName, gotNamespace, err := parseNamespacedImportID(tt.id, "application set")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseNamespacedImportID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if gotName != tt.wantName || gotNamespace != tt.wantNamespace {
+				t.Errorf("parseNamespacedImportID() = %s, %s, want %s, %s", gotName, gotNamespace, tt.wantName, tt.wantNamespace)
+			}
+		})
+	}
+}
+
+func Test_applicationSyncPolicyAutomatedWarnings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		syncPolicy  *application.SyncPolicy
+		wantWarning bool
+	}{
+		{
+			name: "No sync policy",
+		},
+		{
+			name:       "Manual sync",
+			syncPolicy: &application.SyncPolicy{},
+		},
+		{
+			name:       "Allow empty with prune",
+			syncPolicy: &application.SyncPolicy{Automated: &application.SyncPolicyAutomated{AllowEmpty: true, Prune: true}},
+		},
+		{
+			name:        "Allow empty without prune",
+			syncPolicy:  &application.SyncPolicy{Automated: &application.SyncPolicyAutomated{AllowEmpty: true}},
+			wantWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := applicationSyncPolicyAutomatedWarnings(application.ApplicationSpec{SyncPolicy: tt.syncPolicy})
+			if (len(diags) > 0) != tt.wantWarning {
+				t.Fatalf("applicationSyncPolicyAutomatedWarnings() = %v, wantWarning %v", diags, tt.wantWarning)
+			}
+
+			if diags.HasError() {
+				t.Errorf("applicationSyncPolicyAutomatedWarnings() returned an error: %v", diags)
+			}
+		})
+	}
+}
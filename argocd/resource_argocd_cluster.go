@@ -4,10 +4,16 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	applicationClient "github.com/dcoppa/argo-cd/v2/pkg/apiclient/application"
 	clusterClient "github.com/dcoppa/argo-cd/v2/pkg/apiclient/cluster"
+	projectClient "github.com/dcoppa/argo-cd/v2/pkg/apiclient/project"
+	application "github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/oboukili/terraform-provider-argocd/internal/features"
 	"github.com/oboukili/terraform-provider-argocd/internal/provider"
 )
 
@@ -18,13 +24,33 @@ func resourceArgoCDCluster() *schema.Resource {
 		ReadContext:   resourceArgoCDClusterRead,
 		UpdateContext: resourceArgoCDClusterUpdate,
 		DeleteContext: resourceArgoCDClusterDelete,
+		CustomizeDiff: resourceArgoCDClusterCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceArgoCDClusterImportState,
 		},
 		Schema: clusterSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
 	}
 }
 
+func resourceArgoCDClusterImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Defaults are not applied upon import, while these attributes are never
+	// read back from ArgoCD
+	for k, v := range map[string]interface{}{
+		"upsert":              false,
+		"wait_for_connection": false,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceArgoCDClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	si := meta.(*provider.ServerInterface)
 	if diags := si.InitClients(ctx); diags != nil {
@@ -36,11 +62,17 @@ func resourceArgoCDClusterCreate(ctx context.Context, d *schema.ResourceData, me
 		return errorToDiagnostics("failed to expand cluster", err)
 	}
 
+	if diags := validateClusterProject(ctx, si, cluster.Project); diags != nil {
+		return diags
+	}
+
 	// Need a full lock here to avoid race conditions between List existing clusters and creating a new one
 	tokenMutexClusters.Lock()
 
 	rtrimmedServer := strings.TrimRight(cluster.Server, "/")
 
+	upsert := d.Get("upsert").(bool)
+
 	// Cluster are unique by "server address" so we should check there is no existing cluster with this address before
 	existingClusters, err := si.ClusterClient.List(ctx, &clusterClient.ClusterQuery{
 		Id: &clusterClient.ClusterID{
@@ -53,7 +85,9 @@ func resourceArgoCDClusterCreate(ctx context.Context, d *schema.ResourceData, me
 		return errorToDiagnostics(fmt.Sprintf("failed to list existing clusters when creating cluster %s", cluster.Server), err)
 	}
 
-	if len(existingClusters.Items) > 0 {
+	// The in-cluster entry is always listed, even when it is only implicitly
+	// registered, in which case it is adopted
+	if len(existingClusters.Items) > 0 && !upsert && !isInCluster(cluster.Server) {
 		for _, existingCluster := range existingClusters.Items {
 			if rtrimmedServer == strings.TrimRight(existingCluster.Server, "/") {
 				tokenMutexClusters.Unlock()
@@ -62,14 +96,17 @@ func resourceArgoCDClusterCreate(ctx context.Context, d *schema.ResourceData, me
 					{
 						Severity: diag.Error,
 						Summary:  fmt.Sprintf("cluster with server address %s already exists", cluster.Server),
+						Detail:   "Set `upsert = true` to adopt the existing cluster.",
 					},
 				}
 			}
 		}
 	}
 
+	start := time.Now()
+
 	c, err := si.ClusterClient.Create(ctx, &clusterClient.ClusterCreateRequest{
-		Cluster: cluster, Upsert: false,
+		Cluster: cluster, Upsert: upsert,
 	})
 	tokenMutexClusters.Unlock()
 
@@ -77,14 +114,70 @@ func resourceArgoCDClusterCreate(ctx context.Context, d *schema.ResourceData, me
 		return argoCDAPIError("create", "cluster", cluster.Server, err)
 	}
 
-	// Check if the name has been defaulted to server (when omitted)
-	if c.Name != "" && c.Name != c.Server {
-		d.SetId(fmt.Sprintf("%s/%s", c.Server, c.Name))
-	} else {
-		d.SetId(c.Server)
+	d.SetId(clusterID(c))
+
+	if upsert && d.Get("invalidate_cache").(bool) {
+		if diags := invalidateClusterCache(ctx, si, d); diags != nil {
+			return diags
+		}
 	}
 
-	return resourceArgoCDClusterRead(ctx, d, meta)
+	var diags diag.Diagnostics
+
+	if d.Get("wait_for_connection").(bool) {
+		monitored, err := waitForClusterConnection(ctx, si, d, start, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return errorToDiagnostics(fmt.Sprintf("error while waiting for cluster %s to be connected", cluster.Server), err)
+		}
+
+		if !monitored {
+			diags = append(diags, clusterNotMonitoredWarning(cluster.Server))
+		}
+	}
+
+	return append(diags, resourceArgoCDClusterRead(ctx, d, meta)...)
+}
+
+func resourceArgoCDClusterCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Only the in-cluster entry can be connected to without credentials, using
+	// the service account of ArgoCD. Credentials that are not known until
+	// apply are reported as unset, and can hence not be checked.
+	_, configOk := d.GetOk("config")
+	_, kubeconfigOk := d.GetOk("kubeconfig")
+	credentialsKnown := d.NewValueKnown("config") && d.NewValueKnown("kubeconfig")
+
+	if !configOk && !kubeconfigOk && credentialsKnown && d.NewValueKnown("server") && !isInCluster(d.Get("server").(string)) {
+		return fmt.Errorf("one of `config` or `kubeconfig` must be set, unless server is %s", application.KubernetesInternalAPIServerAddr)
+	}
+
+	if !d.HasChanges("kubeconfig", "context") || !d.NewValueKnown("kubeconfig") || !d.NewValueKnown("context") {
+		return nil
+	}
+
+	kubeconfig, ok := d.GetOk("kubeconfig")
+	if !ok || !d.GetRawConfig().GetAttr("server").IsNull() {
+		return nil
+	}
+
+	// The server defaults to the one of the kubeconfig, which may change
+	server, _, err := expandClusterKubeconfig(kubeconfig.(string), d.Get("context").(string))
+	if err != nil {
+		return err
+	}
+
+	if o, _ := d.GetChange("server"); o.(string) == server {
+		return nil
+	}
+
+	if err = d.SetNew("server", server); err != nil {
+		return err
+	}
+
+	if d.Id() != "" {
+		return d.ForceNew("server")
+	}
+
+	return nil
 }
 
 func resourceArgoCDClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -124,15 +217,46 @@ func resourceArgoCDClusterUpdate(ctx context.Context, d *schema.ResourceData, me
 		return errorToDiagnostics(fmt.Sprintf("failed to expand cluster %s", d.Id()), err)
 	}
 
+	if d.HasChange("project") {
+		if diags := validateClusterProject(ctx, si, cluster.Project); diags != nil {
+			return diags
+		}
+	}
+
+	start := time.Now()
+
+	// Credentials are rotated in place, as the whole cluster is updated
 	tokenMutexClusters.Lock()
-	_, err = si.ClusterClient.Update(ctx, &clusterClient.ClusterUpdateRequest{Cluster: cluster})
+	c, err := si.ClusterClient.Update(ctx, &clusterClient.ClusterUpdateRequest{Cluster: cluster})
 	tokenMutexClusters.Unlock()
 
 	if err != nil {
 		return argoCDAPIError("update", "cluster", cluster.Server, err)
 	}
 
-	return resourceArgoCDClusterRead(ctx, d, meta)
+	// The ID embeds the name of the cluster, which may have been updated
+	d.SetId(clusterID(c))
+
+	if d.Get("invalidate_cache").(bool) {
+		if diags := invalidateClusterCache(ctx, si, d); diags != nil {
+			return diags
+		}
+	}
+
+	var diags diag.Diagnostics
+
+	if d.Get("wait_for_connection").(bool) {
+		monitored, err := waitForClusterConnection(ctx, si, d, start, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return errorToDiagnostics(fmt.Sprintf("error while waiting for cluster %s to be connected", cluster.Server), err)
+		}
+
+		if !monitored {
+			diags = append(diags, clusterNotMonitoredWarning(cluster.Server))
+		}
+	}
+
+	return append(diags, resourceArgoCDClusterRead(ctx, d, meta)...)
 }
 
 func resourceArgoCDClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -141,6 +265,39 @@ func resourceArgoCDClusterDelete(ctx context.Context, d *schema.ResourceData, me
 		return pluginSDKDiags(diags)
 	}
 
+	if !d.Get("force_delete").(bool) {
+		apps, err := si.ApplicationClient.List(ctx, &applicationClient.ApplicationQuery{})
+		if err != nil {
+			return argoCDAPIError("list", "applications targeting cluster", d.Id(), err)
+		}
+
+		server := strings.TrimRight(d.Get("server").(string), "/")
+		name := d.Get("name").(string)
+
+		// Deleting the in-cluster entry only resets it, hence applications only
+		// lose their destination if they target it by a custom name
+		inCluster := isInCluster(server)
+
+		var names []string
+
+		for _, app := range apps.Items {
+			dest := app.Spec.Destination
+			if (dest.Server != "" && !inCluster && strings.TrimRight(dest.Server, "/") == server) || (dest.Name != "" && dest.Name == name && !(inCluster && name == inClusterName)) {
+				names = append(names, fmt.Sprintf("%s/%s", app.Namespace, app.Name))
+			}
+		}
+
+		if len(names) > 0 {
+			return []diag.Diagnostic{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("cluster %s is still targeted by %d application(s)", d.Id(), len(names)),
+					Detail:   fmt.Sprintf("The following applications target cluster %s and must be deleted or moved to another cluster first (or set force_delete = true): %s", d.Id(), strings.Join(names, ", ")),
+				},
+			}
+		}
+	}
+
 	tokenMutexClusters.Lock()
 	_, err := si.ClusterClient.Delete(ctx, getClusterQueryFromID(d))
 	tokenMutexClusters.Unlock()
@@ -159,6 +316,110 @@ func resourceArgoCDClusterDelete(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
+// invalidateClusterCache requests the application controller to invalidate its
+// cache of the cluster, which is then fully resynchronized.
+func invalidateClusterCache(ctx context.Context, si *provider.ServerInterface, d *schema.ResourceData) diag.Diagnostics {
+	tokenMutexClusters.Lock()
+	_, err := si.ClusterClient.InvalidateCache(ctx, getClusterQueryFromID(d))
+	tokenMutexClusters.Unlock()
+
+	if err != nil {
+		return argoCDAPIError("invalidate cache of", "cluster", d.Id(), err)
+	}
+
+	return nil
+}
+
+// waitForClusterConnection blocks until the application controller reports the
+// state of the connection to the cluster, as evaluated after `since`. It
+// returns false when the cluster is not monitored by the application
+// controller (i.e. it is targeted by no application), in which case the
+// connection state is never evaluated.
+func waitForClusterConnection(ctx context.Context, si *provider.ServerInterface, d *schema.ResourceData, since time.Time, timeout time.Duration) (bool, error) {
+	monitored := true
+
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		tokenMutexClusters.RLock()
+		c, err := si.ClusterClient.Get(ctx, getClusterQueryFromID(d))
+		tokenMutexClusters.RUnlock()
+
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to get cluster: %w", err))
+		}
+
+		cs := c.Info.ConnectionState
+
+		// Connection states evaluated prior to the change are irrelevant, bearing
+		// in mind that their timestamps only have a precision of one second
+		if cs.ModifiedAt == nil || cs.ModifiedAt.Time.Before(since.Truncate(time.Second)) {
+			return retry.RetryableError(fmt.Errorf("connection state of the cluster has not been refreshed yet"))
+		}
+
+		switch {
+		case cs.Status == application.ConnectionStatusSuccessful:
+			return nil
+		case cs.Status == application.ConnectionStatusFailed:
+			return retry.NonRetryableError(fmt.Errorf("connection failed: %s", cs.Message))
+		case cs.Status == application.ConnectionStatusUnknown && c.Info.ApplicationsCount == 0 && cs.Message != "":
+			monitored = false
+			return nil
+		default:
+			return retry.RetryableError(fmt.Errorf("expected connection status to be Successful but was %s", cs.Status))
+		}
+	})
+
+	return monitored, err
+}
+
+// clusterNotMonitoredWarning reports that the connection to a cluster could
+// not be checked by the application controller.
+func clusterNotMonitoredWarning(server string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("connection to cluster %s could not be checked by the application controller", server),
+		Detail:   "Clusters targeted by no application are not monitored by the application controller. Only the connection check performed by ArgoCD upon registering the cluster has been done.",
+	}
+}
+
+// validateClusterProject ensures that the ArgoCD server supports project-scoped
+// clusters and that the project the cluster is scoped to exists.
+func validateClusterProject(ctx context.Context, si *provider.ServerInterface, project string) diag.Diagnostics {
+	if project == "" {
+		return nil
+	}
+
+	if !si.IsFeatureSupported(features.ProjectScopedClusters) {
+		return featureNotSupported(features.ProjectScopedClusters)
+	}
+
+	_, err := si.ProjectClient.Get(ctx, &projectClient.ProjectQuery{Name: project})
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			return errorToDiagnostics(fmt.Sprintf("project %s does not exist", project), nil)
+		}
+
+		return argoCDAPIError("read", "project", project, err)
+	}
+
+	return nil
+}
+
+// isInCluster returns whether server is the address of the cluster ArgoCD is
+// running in, which ArgoCD implicitly registers as the in-cluster entry.
+func isInCluster(server string) bool {
+	return strings.TrimRight(server, "/") == application.KubernetesInternalAPIServerAddr
+}
+
+// clusterID returns the ID of a cluster, which only consists of its server
+// address when its name has been defaulted to the server (when omitted).
+func clusterID(c *application.Cluster) string {
+	if c.Name != "" && c.Name != c.Server {
+		return fmt.Sprintf("%s/%s", c.Server, c.Name)
+	}
+
+	return c.Server
+}
+
 func getClusterQueryFromID(d *schema.ResourceData) *clusterClient.ClusterQuery {
 	cq := &clusterClient.ClusterQuery{}
 
@@ -1,6 +1,7 @@
 package argocd
 
 import (
+	"encoding/base64"
 	"fmt"
 	"regexp"
 	"runtime"
@@ -78,11 +79,106 @@ func TestAccArgoCDCluster(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDCluster_credentialsRotation(t *testing.T) {
+	clusterName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDClusterBearerToken(clusterName),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_cluster.simple",
+					"config.0.tls_client_config.0.insecure",
+					"true",
+				),
+			},
+			{
+				// Switching credentials updates the cluster in place
+				Config: testAccArgoCDClusterRotatedCredentials(t, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.simple",
+						"info.0.connection_state.0.status",
+						"Successful",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.simple",
+						"config.0.tls_client_config.0.insecure",
+						"false",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDCluster_kubeconfig(t *testing.T) {
+	clusterName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDClusterKubeconfig(t, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.kubeconfig",
+						"server",
+						"https://kubernetes.default.svc.cluster.local",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.kubeconfig",
+						"info.0.connection_state.0.status",
+						"Successful",
+					),
+					resource.TestCheckNoResourceAttr(
+						"argocd_cluster.kubeconfig",
+						"config.#",
+					),
+				),
+			},
+			{
+				// Configuration derived from a kubeconfig must not produce diffs
+				Config:   testAccArgoCDClusterKubeconfig(t, clusterName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccArgoCDCluster_kubeconfigUnknownAtPlan(t *testing.T) {
+	clusterName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// The kubeconfig is only known upon apply, and must not be
+				// reported as missing at plan time
+				Config: testAccArgoCDClusterKubeconfigUnknownAtPlan(t, clusterName),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_cluster.kubeconfig",
+					"info.0.connection_state.0.status",
+					"Successful",
+				),
+			},
+		},
+	})
+}
+
 func TestAccArgoCDCluster_projectScope(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDClusterProjectScope(acctest.RandString(10), "nonexistent-project"),
+				ExpectError: regexp.MustCompile("project nonexistent-project does not exist"),
+			},
 			{
 				Config: testAccArgoCDClusterProjectScope(acctest.RandString(10), "myproject1"),
 				Check: resource.ComposeTestCheckFunc(
@@ -113,6 +209,97 @@ func TestAccArgoCDCluster_projectScope(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDCluster_shard(t *testing.T) {
+	clusterName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDClusterShard(clusterName, `shard = "-1"`),
+				ExpectError: regexp.MustCompile("String input must match a positive integer"),
+			},
+			{
+				Config: testAccArgoCDClusterShard(clusterName, `shard = "1"`),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_cluster.shard",
+					"shard",
+					"1",
+				),
+			},
+			{
+				Config: testAccArgoCDClusterShard(clusterName, `shard = "0"`),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_cluster.shard",
+					"shard",
+					"0",
+				),
+			},
+			{
+				Config: testAccArgoCDClusterShard(clusterName, ""),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_cluster.shard",
+					"shard",
+					"",
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDCluster_waitForConnection(t *testing.T) {
+	clusterName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDClusterWaitForConnection(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.wait",
+						"wait_for_connection",
+						"true",
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_cluster.wait",
+						"info.0.connection_state.0.status",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDCluster_invalidateCache(t *testing.T) {
+	clusterName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDClusterInvalidateCache(clusterName, "foo"),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_cluster.invalidate",
+					"invalidate_cache",
+					"true",
+				),
+			},
+			{
+				Config: testAccArgoCDClusterInvalidateCache(clusterName, "bar"),
+				Check: resource.TestCheckResourceAttr(
+					"argocd_cluster.invalidate",
+					"metadata.0.labels.test",
+					"bar",
+				),
+			},
+		},
+	})
+}
+
 func TestAccArgoCDCluster_optionalName(t *testing.T) {
 	name := acctest.RandString(10)
 
@@ -292,6 +479,87 @@ func TestAccArgoCDCluster_invalidSameServer(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDCluster_upsert(t *testing.T) {
+	clusterName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDClusterUpsert(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"argocd_cluster.adopting",
+						"id",
+						"argocd_cluster.existing",
+						"id",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_cluster.adopting",
+						"upsert",
+						"true",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDCluster_deleteWithApplications(t *testing.T) {
+	clusterName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDClusterWithApplication(clusterName, true),
+				Check: resource.TestCheckResourceAttrSet(
+					"argocd_application.test",
+					"metadata.0.uid",
+				),
+			},
+			{
+				Config:      testAccArgoCDClusterWithApplication(clusterName, false),
+				ExpectError: regexp.MustCompile("is still targeted by 1 application"),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDCluster_inCluster(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDClusterWithoutConfig(),
+				ExpectError: regexp.MustCompile("one of `config` or `kubeconfig` must be set"),
+			},
+			{
+				Config: testAccArgoCDClusterInCluster(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_cluster.in_cluster", "id", "https://kubernetes.default.svc/in-cluster"),
+					resource.TestCheckResourceAttr("argocd_cluster.in_cluster", "name", "in-cluster"),
+					resource.TestCheckResourceAttr("argocd_cluster.in_cluster", "namespaces.#", "2"),
+					resource.TestCheckResourceAttr("argocd_cluster.in_cluster", "cluster_resources", "true"),
+					resource.TestCheckResourceAttr("argocd_cluster.in_cluster", "shard", "0"),
+					resource.TestCheckResourceAttr("argocd_cluster.in_cluster", "metadata.0.labels.env", "test"),
+					resource.TestCheckNoResourceAttr("argocd_cluster.in_cluster", "config.#"),
+				),
+			},
+			{
+				Config: testAccArgoCDClusterInCluster(`name = "local"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_cluster.in_cluster", "id", "https://kubernetes.default.svc/local"),
+					resource.TestCheckResourceAttr("argocd_cluster.in_cluster", "name", "local"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccArgoCDCluster_namespacesErrorWhenEmpty(t *testing.T) {
 	name := acctest.RandString(10)
 
@@ -359,6 +627,90 @@ EOT
 `, clusterName, rc.KeyData, rc.CertData, rc.CAData, rc.ServerName)
 }
 
+func testAccArgoCDClusterRotatedCredentials(t *testing.T, clusterName string) string {
+	rc, err := getInternalRestConfig()
+	if err != nil {
+		t.Error(err)
+	}
+
+	return fmt.Sprintf(`
+resource "argocd_cluster" "simple" {
+  server = "https://kubernetes.default.svc.cluster.local"
+  name   = "%s"
+  shard  = "1"
+  namespaces = ["default", "foo"]
+  config {
+    tls_client_config {
+      key_data    = <<EOT
+%s
+EOT
+      cert_data   = <<EOT
+%s
+EOT
+      ca_data     = <<EOT
+%s
+EOT
+      server_name = "%s"
+      insecure    = false
+    }
+  }
+}
+`, clusterName, rc.KeyData, rc.CertData, rc.CAData, rc.ServerName)
+}
+
+func testAccArgoCDClusterKubeconfig(t *testing.T, clusterName string) string {
+	return fmt.Sprintf(`
+resource "argocd_cluster" "kubeconfig" {
+  name       = "%s"
+  kubeconfig = <<EOT
+%sEOT
+}
+`, clusterName, testAccArgoCDClusterKubeconfigContent(t))
+}
+
+func testAccArgoCDClusterKubeconfigUnknownAtPlan(t *testing.T, clusterName string) string {
+	return fmt.Sprintf(`
+resource "terraform_data" "kubeconfig" {
+  input = <<EOT
+%sEOT
+}
+
+resource "argocd_cluster" "kubeconfig" {
+  server     = "https://kubernetes.default.svc.cluster.local"
+  name       = "%s"
+  kubeconfig = terraform_data.kubeconfig.output
+}
+`, testAccArgoCDClusterKubeconfigContent(t), clusterName)
+}
+
+func testAccArgoCDClusterKubeconfigContent(t *testing.T) string {
+	rc, err := getInternalRestConfig()
+	if err != nil {
+		t.Error(err)
+	}
+
+	return fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: argocd
+clusters:
+- name: argocd
+  cluster:
+    server: https://kubernetes.default.svc.cluster.local
+    tls-server-name: %s
+    certificate-authority-data: %s
+users:
+- name: argocd
+  user:
+    client-certificate-data: %s
+    client-key-data: %s
+contexts:
+- name: argocd
+  context:
+    cluster: argocd
+    user: argocd
+`, rc.ServerName, base64.StdEncoding.EncodeToString(rc.CAData), base64.StdEncoding.EncodeToString(rc.CertData), base64.StdEncoding.EncodeToString(rc.KeyData))
+}
+
 func testAccArgoCDClusterProjectScope(clusterName, projectName string) string {
 	return fmt.Sprintf(`
 resource "argocd_cluster" "project_scope" {
@@ -376,6 +728,168 @@ resource "argocd_cluster" "project_scope" {
 `, clusterName, projectName)
 }
 
+func testAccArgoCDClusterShard(clusterName, shard string) string {
+	return fmt.Sprintf(`
+resource "argocd_cluster" "shard" {
+  server = "https://kubernetes.default.svc.cluster.local"
+  name   = "%s"
+  %s
+  config {
+    # Uses Kind's bootstrap token whose ttl is 24 hours after cluster bootstrap.
+    bearer_token = "abcdef.0123456789abcdef"
+    tls_client_config {
+      insecure = true
+    }
+  }
+}
+`, clusterName, shard)
+}
+
+func testAccArgoCDClusterWaitForConnection(clusterName string) string {
+	return fmt.Sprintf(`
+resource "argocd_cluster" "wait" {
+  server              = "https://kubernetes.default.svc.cluster.local"
+  name                = "%s"
+  wait_for_connection = true
+  config {
+    # Uses Kind's bootstrap token whose ttl is 24 hours after cluster bootstrap.
+    bearer_token = "abcdef.0123456789abcdef"
+    tls_client_config {
+      insecure = true
+    }
+  }
+}
+`, clusterName)
+}
+
+func testAccArgoCDClusterInvalidateCache(clusterName, label string) string {
+	return fmt.Sprintf(`
+resource "argocd_cluster" "invalidate" {
+  server           = "https://kubernetes.default.svc.cluster.local"
+  name             = "%s"
+  invalidate_cache = true
+
+  metadata {
+    labels = {
+      test = "%s"
+    }
+  }
+
+  config {
+    # Uses Kind's bootstrap token whose ttl is 24 hours after cluster bootstrap.
+    bearer_token = "abcdef.0123456789abcdef"
+    tls_client_config {
+      insecure = true
+    }
+  }
+}
+`, clusterName, label)
+}
+
+func testAccArgoCDClusterUpsert(clusterName string) string {
+	return fmt.Sprintf(`
+resource "argocd_cluster" "existing" {
+  server = "https://kubernetes.default.svc.cluster.local"
+  name   = "%[1]s"
+  config {
+    # Uses Kind's bootstrap token whose ttl is 24 hours after cluster bootstrap.
+    bearer_token = "abcdef.0123456789abcdef"
+    tls_client_config {
+      insecure = true
+    }
+  }
+}
+
+resource "argocd_cluster" "adopting" {
+  server = "https://kubernetes.default.svc.cluster.local"
+  name   = "%[1]s"
+  upsert = true
+  config {
+    # Uses Kind's bootstrap token whose ttl is 24 hours after cluster bootstrap.
+    bearer_token = "abcdef.0123456789abcdef"
+    tls_client_config {
+      insecure = true
+    }
+  }
+
+  depends_on = [argocd_cluster.existing]
+}
+`, clusterName)
+}
+
+func testAccArgoCDClusterWithApplication(clusterName string, withCluster bool) string {
+	cluster := fmt.Sprintf(`
+resource "argocd_cluster" "test" {
+  server = "https://kubernetes.default.svc.cluster.local"
+  name   = "%s"
+  config {
+    # Uses Kind's bootstrap token whose ttl is 24 hours after cluster bootstrap.
+    bearer_token = "abcdef.0123456789abcdef"
+    tls_client_config {
+      insecure = true
+    }
+  }
+}
+`, clusterName)
+
+	dependsOn := "depends_on = [argocd_cluster.test]"
+
+	if !withCluster {
+		cluster = ""
+		dependsOn = ""
+	}
+
+	return cluster + fmt.Sprintf(`
+resource "argocd_application" "test" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps.git"
+      path            = "guestbook"
+      target_revision = "HEAD"
+    }
+
+    destination {
+      name      = "%[1]s"
+      namespace = "default"
+    }
+  }
+
+  %[2]s
+}
+`, clusterName, dependsOn)
+}
+
+func testAccArgoCDClusterInCluster(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_cluster" "in_cluster" {
+  server            = "https://kubernetes.default.svc"
+  %s
+  namespaces        = ["default", "argocd"]
+  cluster_resources = true
+  shard             = "0"
+
+  metadata {
+    labels = {
+      env = "test"
+    }
+  }
+}
+`, name)
+}
+
+func testAccArgoCDClusterWithoutConfig() string {
+	return `
+resource "argocd_cluster" "without_config" {
+  server = "https://kubernetes.default.svc.cluster.local"
+}
+`
+}
+
 func testAccArgoCDClusterMetadata(clusterName string) string {
 	return fmt.Sprintf(`
 resource "argocd_cluster" "cluster_metadata" {
@@ -7,27 +7,109 @@ import (
 	"sync"
 	"time"
 
+	applicationClient "github.com/dcoppa/argo-cd/v2/pkg/apiclient/application"
 	projectClient "github.com/dcoppa/argo-cd/v2/pkg/apiclient/project"
 	application "github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/oboukili/terraform-provider-argocd/internal/features"
 	"github.com/oboukili/terraform-provider-argocd/internal/provider"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func resourceArgoCDProject() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Manages [projects](https://argo-cd.readthedocs.io/en/stable/user-guide/projects/) within ArgoCD.",
+		Description:   "Manages [projects](https://argo-cd.readthedocs.io/en/stable/user-guide/projects/) within ArgoCD. A project can be turned into a [global project](https://argo-cd.readthedocs.io/en/stable/user-guide/projects/#configuring-global-projects-v18) by referencing it, along with a label selector matching the `metadata.labels` of other projects, in the `globalProjects` setting of the `argocd-cm` ConfigMap (which has to be managed outside of this provider, e.g. with the `kubernetes` provider).",
 		CreateContext: resourceArgoCDProjectCreate,
 		ReadContext:   resourceArgoCDProjectRead,
 		UpdateContext: resourceArgoCDProjectUpdate,
 		DeleteContext: resourceArgoCDProjectDelete,
+		CustomizeDiff: resourceArgoCDProjectCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceArgoCDProjectImportState,
 		},
 		Schema: map[string]*schema.Schema{
 			"metadata": metadataSchema("appprojects.argoproj.io"),
 			"spec":     projectSpecSchemaV2(),
+			"manifest": {
+				Type:             schema.TypeString,
+				Description:      "Full `AppProject` manifest, in YAML or JSON format, as an alternative to `spec` allowing existing projects to be onboarded verbatim. Only the `spec` of the manifest is managed, the project metadata must be configured through `metadata`, with which `metadata.name` and `metadata.namespace` of the manifest must match when set. Manifests are compared once normalized, hence formatting changes do not produce diffs. Exactly one of `spec` or `manifest` must be set.",
+				Optional:         true,
+				ValidateFunc:     validateProjectManifest,
+				DiffSuppressFunc: suppressEquivalentProjectManifests,
+			},
+			"force_delete": {
+				Type:        schema.TypeBool,
+				Description: "Whether to delete the project even though applications still belong to it. By default, the deletion fails with the list of these applications, as applications whose project does not exist anymore cannot be synced nor managed. Note that ArgoCD itself always refuses to delete projects referenced by applications living in its own namespace.",
+				Optional:    true,
+			},
+			"manage_roles": {
+				Type:        schema.TypeBool,
+				Description: "Whether the roles of the project are managed by this resource. When `false`, `spec.role` must not be set and the roles of the project are left untouched, so that they can be managed by other tools, Terraform workspaces or `argocd_project_role` resources.",
+				Optional:    true,
+				Default:     true,
+			},
+			"manage_sync_windows": {
+				Type:        schema.TypeBool,
+				Description: "Whether the sync windows of the project are managed by this resource. When `false`, `spec.sync_window` must not be set and the sync windows of the project are left untouched, so that they can be managed by other tools, Terraform workspaces or `argocd_project_sync_window` resources.",
+				Optional:    true,
+				Default:     true,
+			},
+			"revoke_removed_role_tokens": {
+				Type:        schema.TypeBool,
+				Description: "Whether to revoke the JWT tokens issued for roles that are removed from the project, prior to removing the roles. Only applies when `manage_roles` is enabled. Each token is revoked individually through the project API, so that the revocation is recorded by ArgoCD. Set to `false` to leave the tokens of removed roles untouched.",
+				Optional:    true,
+				Default:     true,
+			},
+			"global_projects": {
+				Type:        schema.TypeList,
+				Description: "Names of the global projects whose configuration is inherited by this project, as per the `globalProjects` setting of the `argocd-cm` ConfigMap.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"scoped_repositories": {
+				Type:        schema.TypeList,
+				Description: "URLs of the [project-scoped repositories](https://argo-cd.readthedocs.io/en/stable/user-guide/projects/#project-scoped-repositories-and-clusters) registered against this project, i.e. whose `project` is set to the name of this project.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"scoped_clusters": {
+				Type:        schema.TypeList,
+				Description: "Server URLs of the [project-scoped clusters](https://argo-cd.readthedocs.io/en/stable/user-guide/projects/#project-scoped-repositories-and-clusters) registered against this project, i.e. whose `project` is set to the name of this project.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"role_tokens": {
+				Type:        schema.TypeList,
+				Description: "JWT tokens issued for the roles of the project, including tokens which are not managed through `argocd_project_token` resources. The tokens themselves are not exposed.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:        schema.TypeString,
+							Description: "Name of the role the token was issued for.",
+							Computed:    true,
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Description: "Token identifier.",
+							Computed:    true,
+						},
+						"issued_at": {
+							Type:        schema.TypeInt,
+							Description: "Unix timestamp at which the token was issued.",
+							Computed:    true,
+						},
+						"expires_at": {
+							Type:        schema.TypeInt,
+							Description: "Unix timestamp upon which the token will expire, `0` if it does not expire.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 		SchemaVersion: 2,
 		StateUpgraders: []schema.StateUpgrader{
@@ -58,11 +140,8 @@ func resourceArgoCDProjectCreate(ctx context.Context, d *schema.ResourceData, me
 
 	projectName := objectMeta.Name
 
-	if !si.IsFeatureSupported(features.ProjectSourceNamespaces) {
-		_, sourceNamespacesOk := d.GetOk("spec.0.source_namespaces")
-		if sourceNamespacesOk {
-			return featureNotSupported(features.ProjectSourceNamespaces)
-		}
+	if !si.IsFeatureSupported(features.ProjectSourceNamespaces) && len(spec.SourceNamespaces) > 0 {
+		return featureNotSupported(features.ProjectSourceNamespaces)
 	}
 
 	if _, ok := tokenMutexProjectMap[projectName]; !ok {
@@ -146,11 +225,45 @@ func resourceArgoCDProjectRead(ctx context.Context, d *schema.ResourceData, meta
 		return errorToDiagnostics(fmt.Sprintf("failed to flatten project %s", d.Id()), err)
 	}
 
+	dp, err := si.ProjectClient.GetDetailedProject(ctx, &projectClient.ProjectQuery{
+		Name: projectName,
+	})
+	if err != nil {
+		return argoCDAPIError("read", "details of project", projectName, err)
+	}
+
+	globalProjects := make([]string, 0, len(dp.GlobalProjects))
+	for _, gp := range dp.GlobalProjects {
+		globalProjects = append(globalProjects, gp.Name)
+	}
+
+	if err = d.Set("global_projects", globalProjects); err != nil {
+		return errorToDiagnostics(fmt.Sprintf("failed to set global projects of project %s", d.Id()), err)
+	}
+
+	scopedRepositories := make([]string, 0, len(dp.Repositories))
+	for _, r := range dp.Repositories {
+		scopedRepositories = append(scopedRepositories, r.Repo)
+	}
+
+	if err = d.Set("scoped_repositories", scopedRepositories); err != nil {
+		return errorToDiagnostics(fmt.Sprintf("failed to set scoped repositories of project %s", d.Id()), err)
+	}
+
+	scopedClusters := make([]string, 0, len(dp.Clusters))
+	for _, c := range dp.Clusters {
+		scopedClusters = append(scopedClusters, c.Server)
+	}
+
+	if err = d.Set("scoped_clusters", scopedClusters); err != nil {
+		return errorToDiagnostics(fmt.Sprintf("failed to set scoped clusters of project %s", d.Id()), err)
+	}
+
 	return nil
 }
 
 func resourceArgoCDProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if ok := d.HasChanges("metadata", "spec"); !ok {
+	if ok := d.HasChanges("metadata", "spec", "manifest"); !ok {
 		return resourceArgoCDProjectRead(ctx, d, meta)
 	}
 
@@ -164,71 +277,136 @@ func resourceArgoCDProjectUpdate(ctx context.Context, d *schema.ResourceData, me
 		return errorToDiagnostics(fmt.Sprintf("failed to expand project %s", d.Id()), err)
 	}
 
-	if !si.IsFeatureSupported(features.ProjectSourceNamespaces) {
-		_, sourceNamespacesOk := d.GetOk("spec.0.source_namespaces")
-		if sourceNamespacesOk {
-			return featureNotSupported(features.ProjectSourceNamespaces)
-		}
+	if !si.IsFeatureSupported(features.ProjectSourceNamespaces) && len(spec.SourceNamespaces) > 0 {
+		return featureNotSupported(features.ProjectSourceNamespaces)
 	}
 
 	projectName := objectMeta.Name
 
-	if _, ok := tokenMutexProjectMap[projectName]; !ok {
-		tokenMutexProjectMap[projectName] = &sync.RWMutex{}
-	}
+	manageRoles := d.Get("manage_roles").(bool)
+	manageSyncWindows := d.Get("manage_sync_windows").(bool)
 
-	projectRequest := &projectClient.ProjectUpdateRequest{
-		Project: &application.AppProject{
-			ObjectMeta: objectMeta,
-			Spec:       spec,
-		},
+	if manageRoles && d.HasChanges("spec.0.role", "manifest") && d.Get("revoke_removed_role_tokens").(bool) {
+		if _, ok := tokenMutexProjectMap[projectName]; !ok {
+			tokenMutexProjectMap[projectName] = &sync.RWMutex{}
+		}
+
+		tokenMutexProjectMap[projectName].Lock()
+		err = revokeRemovedProjectRoleTokens(ctx, si, projectName, spec.Roles)
+		tokenMutexProjectMap[projectName].Unlock()
+
+		if err != nil {
+			return errorToDiagnostics(fmt.Sprintf("failed to revoke tokens of roles removed from project %s", projectName), err)
+		}
 	}
 
-	tokenMutexProjectMap[projectName].Lock()
+	err = updateProject(ctx, si, projectName, func(p *application.AppProject) error {
+		p.Labels = objectMeta.Labels
+		p.Annotations = objectMeta.Annotations
 
-	p, err := si.ProjectClient.Get(ctx, &projectClient.ProjectQuery{
-		Name: d.Id(),
+		if manageRoles {
+			// Preserve preexisting JWTs for managed roles
+			for i, r := range spec.Roles {
+				if pr, j, _ := p.GetRoleByName(r.Name); j != -1 {
+					spec.Roles[i].JWTTokens = pr.JWTTokens
+				}
+			}
+		} else {
+			spec.Roles = p.Spec.Roles
+		}
+
+		if !manageSyncWindows {
+			spec.SyncWindows = p.Spec.SyncWindows
+		}
+
+		p.Spec = spec
+
+		return nil
 	})
 	if err != nil {
-		tokenMutexProjectMap[projectName].Unlock()
+		return argoCDAPIError("update", "project", projectName, err)
+	}
 
-		return errorToDiagnostics(fmt.Sprintf("failed to get existing project when updating project %s", projectName), err)
-	} else if p != nil {
-		// Kubernetes API requires providing the up-to-date correct ResourceVersion for updates
-		projectRequest.Project.ResourceVersion = p.ResourceVersion
+	return resourceArgoCDProjectRead(ctx, d, meta)
+}
 
-		// Preserve preexisting JWTs for managed roles
-		roles := expandProjectRoles(d.Get("spec.0.role").([]interface{}))
+func resourceArgoCDProjectCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	var roles, syncWindows int
 
-		for _, r := range roles {
-			var pr *application.ProjectRole
+	if m, ok := d.GetOk("manifest"); ok && d.NewValueKnown("manifest") {
+		p, err := parseProjectManifest(m.(string))
+		if err != nil {
+			return err
+		}
 
-			var i int
+		roles, syncWindows = len(p.Spec.Roles), len(p.Spec.SyncWindows)
+	} else {
+		roles, syncWindows = d.Get("spec.0.role.#").(int), d.Get("spec.0.sync_window.#").(int)
+	}
 
-			pr, i, err = p.GetRoleByName(r.Name)
-			if err != nil {
-				// i == -1 means the role does not exist
-				// and was recently added within Terraform tf files
-				if i != -1 {
-					tokenMutexProjectMap[projectName].Unlock()
+	if !d.Get("manage_roles").(bool) && roles > 0 {
+		return fmt.Errorf("roles cannot be set when manage_roles is disabled")
+	}
 
-					return errorToDiagnostics(fmt.Sprintf("project role %s could not be retrieved", r.Name), err)
-				}
-			} else { // Only preserve preexisting JWTs for managed roles if we found an existing matching project
-				projectRequest.Project.Spec.Roles[i].JWTTokens = pr.JWTTokens
-			}
-		}
+	if !d.Get("manage_sync_windows").(bool) && syncWindows > 0 {
+		return fmt.Errorf("sync windows cannot be set when manage_sync_windows is disabled")
 	}
 
-	_, err = si.ProjectClient.Update(ctx, projectRequest)
+	return nil
+}
 
-	tokenMutexProjectMap[projectName].Unlock()
+func resourceArgoCDProjectImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Defaults are not applied upon import, while they determine which parts
+	// of the project are read
+	for _, k := range []string{"manage_roles", "manage_sync_windows", "revoke_removed_role_tokens"} {
+		if err := d.Set(k, true); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
 
+// revokeRemovedProjectRoleTokens deletes the JWT tokens of the roles of the
+// project which are not part of roles. Tokens must be deleted before their
+// role, as ArgoCD only allows deleting tokens of existing roles.
+func revokeRemovedProjectRoleTokens(ctx context.Context, si *provider.ServerInterface, projectName string, roles []application.ProjectRole) error {
+	p, err := si.ProjectClient.Get(ctx, &projectClient.ProjectQuery{
+		Name: projectName,
+	})
 	if err != nil {
-		return argoCDAPIError("update", "project", projectName, err)
+		return err
 	}
 
-	return resourceArgoCDProjectRead(ctx, d, meta)
+	managed := make(map[string]bool, len(roles))
+	for _, r := range roles {
+		managed[r.Name] = true
+	}
+
+	for _, r := range p.Spec.Roles {
+		if managed[r.Name] {
+			continue
+		}
+
+		tokens := r.JWTTokens
+		if t, ok := p.Status.JWTTokensByRole[r.Name]; ok {
+			tokens = t.Items
+		}
+
+		for _, t := range tokens {
+			_, err = si.ProjectClient.DeleteToken(ctx, &projectClient.ProjectTokenDeleteRequest{
+				Project: projectName,
+				Role:    r.Name,
+				Iat:     t.IssuedAt,
+				Id:      t.ID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to revoke token %s of role %s: %w", t.ID, r.Name, err)
+			}
+		}
+	}
+
+	return nil
 }
 
 func resourceArgoCDProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -239,6 +417,30 @@ func resourceArgoCDProjectDelete(ctx context.Context, d *schema.ResourceData, me
 
 	projectName := d.Id()
 
+	if !d.Get("force_delete").(bool) {
+		apps, err := si.ApplicationClient.List(ctx, &applicationClient.ApplicationQuery{
+			Projects: []string{projectName},
+		})
+		if err != nil {
+			return argoCDAPIError("list", "applications of project", projectName, err)
+		}
+
+		if len(apps.Items) > 0 {
+			names := make([]string, 0, len(apps.Items))
+			for _, app := range apps.Items {
+				names = append(names, fmt.Sprintf("%s/%s", app.Namespace, app.Name))
+			}
+
+			return []diag.Diagnostic{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("project %s still contains %d application(s)", projectName, len(names)),
+					Detail:   fmt.Sprintf("The following applications belong to project %s and must be deleted or moved to another project first (or set force_delete = true): %s", projectName, strings.Join(names, ", ")),
+				},
+			}
+		}
+	}
+
 	if _, ok := tokenMutexProjectMap[projectName]; !ok {
 		tokenMutexProjectMap[projectName] = &sync.RWMutex{}
 	}
@@ -255,3 +457,46 @@ func resourceArgoCDProjectDelete(ctx context.Context, d *schema.ResourceData, me
 
 	return nil
 }
+
+// updateProject applies mutate to the latest version of a project and updates
+// it, retrying with an exponential backoff when the project has been
+// concurrently modified (e.g. upon token issuance or through the UI), in which
+// case mutate is applied again to the newly retrieved project. It allows
+// resources to manage a single part of a project (e.g. a sync window) without
+// overwriting changes made to the rest of it.
+func updateProject(ctx context.Context, si *provider.ServerInterface, projectName string, mutate func(p *application.AppProject) error) error {
+	if _, ok := tokenMutexProjectMap[projectName]; !ok {
+		tokenMutexProjectMap[projectName] = &sync.RWMutex{}
+	}
+
+	tokenMutexProjectMap[projectName].Lock()
+	defer tokenMutexProjectMap[projectName].Unlock()
+
+	return retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
+		p, err := si.ProjectClient.Get(ctx, &projectClient.ProjectQuery{
+			Name: projectName,
+		})
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if err = mutate(p); err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		_, err = si.ProjectClient.Update(ctx, &projectClient.ProjectUpdateRequest{
+			Project: p,
+		})
+		if err != nil {
+			// Project has been modified since we read it, e.g. by another
+			// resource or ArgoCD client (conflicts are reported as aborted)
+			if status.Code(err) == codes.Aborted {
+				return retry.RetryableError(err)
+			}
+
+			return retry.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,232 @@
+package argocd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	projectClient "github.com/dcoppa/argo-cd/v2/pkg/apiclient/project"
+	application "github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/oboukili/terraform-provider-argocd/internal/provider"
+)
+
+func resourceArgoCDProjectRole() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages a single RBAC role of an existing ArgoCD project, allowing several teams or Terraform workspaces to manage their own roles within a shared project. JWT tokens issued for the role (e.g. through `argocd_project_token`) are preserved. **Note**: roles of a project must either be managed through this resource or through `spec.role` blocks of `argocd_project`, not both. When the project itself is managed by Terraform, set `manage_roles = false` on the `argocd_project` resource.",
+		CreateContext: resourceArgoCDProjectRoleCreate,
+		ReadContext:   resourceArgoCDProjectRoleRead,
+		UpdateContext: resourceArgoCDProjectRoleUpdate,
+		DeleteContext: resourceArgoCDProjectRoleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceArgoCDProjectRoleImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Description: "Name of the project the role belongs to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "Name of the role.",
+				ValidateFunc: validateRoleName,
+				Required:     true,
+				ForceNew:     true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the role.",
+				Optional:    true,
+			},
+			"policies": {
+				Type:        schema.TypeList,
+				Description: "List of casbin formatted strings that define access policies for the role in the project. For more information, see the [ArgoCD RBAC reference](https://argoproj.github.io/argo-cd/operator-manual/rbac/#rbac-permission-structure).",
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"groups": {
+				Type:        schema.TypeList,
+				Description: "List of OIDC group claims bound to this role.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceArgoCDProjectRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	si := meta.(*provider.ServerInterface)
+	if diags := si.InitClients(ctx); diags != nil {
+		return pluginSDKDiags(diags)
+	}
+
+	projectName := d.Get("project").(string)
+
+	role, err := expandProjectRole(d)
+	if err != nil {
+		return errorToDiagnostics("failed to expand project role", err)
+	}
+
+	err = updateProject(ctx, si, projectName, func(p *application.AppProject) error {
+		if _, i, _ := p.GetRoleByName(role.Name); i != -1 {
+			return fmt.Errorf("role %s already exists in project %s", role.Name, projectName)
+		}
+
+		p.Spec.Roles = append(p.Spec.Roles, role)
+
+		return nil
+	})
+	if err != nil {
+		return argoCDAPIError("create", "role", role.Name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", projectName, role.Name))
+
+	return resourceArgoCDProjectRoleRead(ctx, d, meta)
+}
+
+func resourceArgoCDProjectRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	si := meta.(*provider.ServerInterface)
+	if diags := si.InitClients(ctx); diags != nil {
+		return pluginSDKDiags(diags)
+	}
+
+	projectName := d.Get("project").(string)
+	roleName := d.Get("name").(string)
+
+	if _, ok := tokenMutexProjectMap[projectName]; !ok {
+		tokenMutexProjectMap[projectName] = &sync.RWMutex{}
+	}
+
+	tokenMutexProjectMap[projectName].RLock()
+	p, err := si.ProjectClient.Get(ctx, &projectClient.ProjectQuery{
+		Name: projectName,
+	})
+	tokenMutexProjectMap[projectName].RUnlock()
+
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
+
+		return argoCDAPIError("read", "project", projectName, err)
+	}
+
+	r, i, _ := p.GetRoleByName(roleName)
+	if i == -1 {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+
+	if err = d.Set("description", r.Description); err != nil {
+		return errorToDiagnostics("failed to set description", err)
+	}
+
+	policies := normalizeProjectRolePolicies(d.Get("policies").([]interface{}), r.Policies)
+	if err = d.Set("policies", policies); err != nil {
+		return errorToDiagnostics("failed to set policies", err)
+	}
+
+	groups := normalizeStringListOrder(d.Get("groups").([]interface{}), r.Groups)
+	if err = d.Set("groups", groups); err != nil {
+		return errorToDiagnostics("failed to set groups", err)
+	}
+
+	return nil
+}
+
+func resourceArgoCDProjectRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	si := meta.(*provider.ServerInterface)
+	if diags := si.InitClients(ctx); diags != nil {
+		return pluginSDKDiags(diags)
+	}
+
+	projectName := d.Get("project").(string)
+
+	role, err := expandProjectRole(d)
+	if err != nil {
+		return errorToDiagnostics("failed to expand project role", err)
+	}
+
+	err = updateProject(ctx, si, projectName, func(p *application.AppProject) error {
+		r, i, _ := p.GetRoleByName(role.Name)
+		if i == -1 {
+			return fmt.Errorf("role %s no longer exists in project %s", role.Name, projectName)
+		}
+
+		// Preserve preexisting JWTs
+		role.JWTTokens = r.JWTTokens
+		p.Spec.Roles[i] = role
+
+		return nil
+	})
+	if err != nil {
+		return argoCDAPIError("update", "role", role.Name, err)
+	}
+
+	return resourceArgoCDProjectRoleRead(ctx, d, meta)
+}
+
+func resourceArgoCDProjectRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	si := meta.(*provider.ServerInterface)
+	if diags := si.InitClients(ctx); diags != nil {
+		return pluginSDKDiags(diags)
+	}
+
+	projectName := d.Get("project").(string)
+	roleName := d.Get("name").(string)
+
+	err := updateProject(ctx, si, projectName, func(p *application.AppProject) error {
+		if _, i, _ := p.GetRoleByName(roleName); i != -1 {
+			p.Spec.Roles = append(p.Spec.Roles[:i], p.Spec.Roles[i+1:]...)
+		}
+
+		return nil
+	})
+	if err != nil && !strings.Contains(err.Error(), "NotFound") {
+		return argoCDAPIError("delete", "role", roleName, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceArgoCDProjectRoleImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	projectName, roleName, ok := strings.Cut(d.Id(), ":")
+	if !ok || projectName == "" || roleName == "" {
+		return nil, fmt.Errorf("invalid project role import ID %q, expected format is <project>:<role>", d.Id())
+	}
+
+	if err := d.Set("project", projectName); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("name", roleName); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandProjectRole(d *schema.ResourceData) (application.ProjectRole, error) {
+	role := application.ProjectRole{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Policies:    expandStringList(d.Get("policies").([]interface{})),
+		Groups:      expandStringList(d.Get("groups").([]interface{})),
+	}
+
+	for _, p := range role.Policies {
+		if err := validatePolicy(d.Get("project").(string), role.Name, p); err != nil {
+			return role, err
+		}
+	}
+
+	return role, nil
+}
@@ -0,0 +1,105 @@
+package argocd
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccArgoCDProjectRole(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDProjectRoleInvalidPolicy(name),
+				ExpectError: regexp.MustCompile("invalid policy rule"),
+			},
+			{
+				Config: testAccArgoCDProjectRole(name, "get"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project_role.test", "id", name+":testrole"),
+					resource.TestCheckResourceAttr("argocd_project_role.test", "policies.#", "1"),
+					resource.TestCheckResourceAttr("argocd_project_role.test", "groups.0", "foo"),
+					resource.TestCheckResourceAttrSet("argocd_project_token.test", "issued_at"),
+				),
+			},
+			{
+				ResourceName:      "argocd_project_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Token must survive role updates
+				Config: testAccArgoCDProjectRole(name, "sync"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project_role.test", "policies.0", fmt.Sprintf("p, proj:%[1]s:testrole, applications, sync, %[1]s/*, allow", name)),
+					resource.TestCheckResourceAttrSet("argocd_project_token.test", "issued_at"),
+				),
+			},
+			{
+				// Tokens of roles managed outside of the project are listed
+				// upon the next refresh
+				Config: testAccArgoCDProjectRole(name, "sync"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project.test", "role_tokens.#", "1"),
+					resource.TestCheckResourceAttr("argocd_project.test", "role_tokens.0.role", "testrole"),
+				),
+			},
+		},
+	})
+}
+
+func testAccArgoCDProjectRole(name, action string) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "test" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  manage_roles = false
+
+  spec {
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "*"
+    }
+  }
+}
+
+resource "argocd_project_role" "test" {
+  project     = argocd_project.test.metadata[0].name
+  name        = "testrole"
+  description = "test role"
+  policies = [
+    "p, proj:%[1]s:testrole, applications, %[2]s, %[1]s/*, allow",
+  ]
+  groups = ["foo"]
+}
+
+resource "argocd_project_token" "test" {
+  project = argocd_project.test.metadata[0].name
+  role    = argocd_project_role.test.name
+}
+`, name, action)
+}
+
+func testAccArgoCDProjectRoleInvalidPolicy(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_project_role" "invalid" {
+  project  = "%[1]s"
+  name     = "invalid"
+  policies = [
+    "p, proj:%[1]s:testrole, applications, get, %[1]s/*, allow",
+  ]
+}
+`, name)
+}
@@ -0,0 +1,243 @@
+package argocd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	projectClient "github.com/dcoppa/argo-cd/v2/pkg/apiclient/project"
+	application "github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/oboukili/terraform-provider-argocd/internal/provider"
+)
+
+func resourceArgoCDProjectSyncWindow() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages a single [sync window](https://argo-cd.readthedocs.io/en/stable/user-guide/sync_windows/) of an existing ArgoCD project, allowing several teams or Terraform workspaces to manage their own windows within a shared project. **Note**: sync windows of a project must either be managed through this resource or through `spec.sync_window` blocks of `argocd_project`, not both. When the project itself is managed by Terraform, set `manage_sync_windows = false` on the `argocd_project` resource.",
+		CreateContext: resourceArgoCDProjectSyncWindowCreate,
+		ReadContext:   resourceArgoCDProjectSyncWindowRead,
+		UpdateContext: resourceArgoCDProjectSyncWindowUpdate,
+		DeleteContext: resourceArgoCDProjectSyncWindowDelete,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Description: "Name of the project the sync window belongs to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"kind": {
+				Type:         schema.TypeString,
+				Description:  "Defines if the window allows or blocks syncs, allowed values are `allow` or `deny`.",
+				Required:     true,
+				ValidateFunc: validateSyncWindowKind,
+			},
+			"schedule": {
+				Type:             schema.TypeString,
+				Description:      "Time the window will begin, specified in cron format (e.g. `0 22 * * *`) and evaluated in `timezone`.",
+				Required:         true,
+				ValidateFunc:     validateSyncWindowSchedule,
+				DiffSuppressFunc: suppressEquivalentSyncWindowSchedules,
+			},
+			"duration": {
+				Type:         schema.TypeString,
+				Description:  "Amount of time the sync window will be open.",
+				Required:     true,
+				ValidateFunc: validateSyncWindowDuration,
+			},
+			"applications": {
+				Type:        schema.TypeList,
+				Description: "List of applications that the window will apply to.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"namespaces": {
+				Type:        schema.TypeList,
+				Description: "List of namespaces that the window will apply to.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"clusters": {
+				Type:        schema.TypeList,
+				Description: "List of clusters that the window will apply to.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"manual_sync": {
+				Type:        schema.TypeBool,
+				Description: "Enables manual syncs when they would otherwise be blocked.",
+				Optional:    true,
+			},
+			"timezone": {
+				Type:             schema.TypeString,
+				Description:      "Timezone that the schedule will be evaluated in, from the IANA time zone database (e.g. `Europe/Paris`).",
+				ValidateFunc:     validateSyncWindowTimezone,
+				DiffSuppressFunc: suppressEquivalentSyncWindowTimezones,
+				Optional:         true,
+				Default:          "UTC",
+			},
+		},
+	}
+}
+
+func resourceArgoCDProjectSyncWindowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	si := meta.(*provider.ServerInterface)
+	if diags := si.InitClients(ctx); diags != nil {
+		return pluginSDKDiags(diags)
+	}
+
+	projectName := d.Get("project").(string)
+	sw := expandProjectSyncWindow(d.Get)
+
+	err := updateProject(ctx, si, projectName, func(p *application.AppProject) error {
+		if i := findProjectSyncWindow(p.Spec.SyncWindows, sw); i != -1 {
+			return fmt.Errorf("an identical sync window already exists in project %s", projectName)
+		}
+
+		p.Spec.SyncWindows = append(p.Spec.SyncWindows, sw)
+
+		return nil
+	})
+	if err != nil {
+		return argoCDAPIError("create", "sync window of project", projectName, err)
+	}
+
+	d.SetId(projectSyncWindowID(projectName, sw))
+
+	return resourceArgoCDProjectSyncWindowRead(ctx, d, meta)
+}
+
+func resourceArgoCDProjectSyncWindowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	si := meta.(*provider.ServerInterface)
+	if diags := si.InitClients(ctx); diags != nil {
+		return pluginSDKDiags(diags)
+	}
+
+	projectName := d.Get("project").(string)
+
+	if _, ok := tokenMutexProjectMap[projectName]; !ok {
+		tokenMutexProjectMap[projectName] = &sync.RWMutex{}
+	}
+
+	tokenMutexProjectMap[projectName].RLock()
+	p, err := si.ProjectClient.Get(ctx, &projectClient.ProjectQuery{
+		Name: projectName,
+	})
+	tokenMutexProjectMap[projectName].RUnlock()
+
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
+
+		return argoCDAPIError("read", "project", projectName, err)
+	}
+
+	// Sync windows have no identifier, hence a window which has been modified
+	// outside of Terraform is considered as deleted
+	if findProjectSyncWindow(p.Spec.SyncWindows, expandProjectSyncWindow(d.Get)) == -1 {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+
+	return nil
+}
+
+func resourceArgoCDProjectSyncWindowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	si := meta.(*provider.ServerInterface)
+	if diags := si.InitClients(ctx); diags != nil {
+		return pluginSDKDiags(diags)
+	}
+
+	projectName := d.Get("project").(string)
+
+	old := expandProjectSyncWindow(func(key string) interface{} {
+		o, _ := d.GetChange(key)
+		return o
+	})
+	sw := expandProjectSyncWindow(d.Get)
+
+	err := updateProject(ctx, si, projectName, func(p *application.AppProject) error {
+		i := findProjectSyncWindow(p.Spec.SyncWindows, old)
+		if i == -1 {
+			return fmt.Errorf("sync window no longer exists in project %s", projectName)
+		}
+
+		p.Spec.SyncWindows[i] = sw
+
+		return nil
+	})
+	if err != nil {
+		return argoCDAPIError("update", "sync window of project", projectName, err)
+	}
+
+	d.SetId(projectSyncWindowID(projectName, sw))
+
+	return resourceArgoCDProjectSyncWindowRead(ctx, d, meta)
+}
+
+func resourceArgoCDProjectSyncWindowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	si := meta.(*provider.ServerInterface)
+	if diags := si.InitClients(ctx); diags != nil {
+		return pluginSDKDiags(diags)
+	}
+
+	projectName := d.Get("project").(string)
+	sw := expandProjectSyncWindow(d.Get)
+
+	err := updateProject(ctx, si, projectName, func(p *application.AppProject) error {
+		if i := findProjectSyncWindow(p.Spec.SyncWindows, sw); i != -1 {
+			p.Spec.SyncWindows = append(p.Spec.SyncWindows[:i], p.Spec.SyncWindows[i+1:]...)
+		}
+
+		return nil
+	})
+	if err != nil && !strings.Contains(err.Error(), "NotFound") {
+		return argoCDAPIError("delete", "sync window of project", projectName, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// expandProjectSyncWindow returns the sync window described by the resource
+// attributes, as returned by get (e.g. d.Get).
+func expandProjectSyncWindow(get func(key string) interface{}) *application.SyncWindow {
+	return &application.SyncWindow{
+		Applications: expandStringList(get("applications").([]interface{})),
+		Clusters:     expandStringList(get("clusters").([]interface{})),
+		Duration:     get("duration").(string),
+		Kind:         get("kind").(string),
+		ManualSync:   get("manual_sync").(bool),
+		Namespaces:   expandStringList(get("namespaces").([]interface{})),
+		Schedule:     get("schedule").(string),
+		TimeZone:     get("timezone").(string),
+	}
+}
+
+// findProjectSyncWindow returns the index of the sync window identical to sw,
+// or -1 if there is none.
+func findProjectSyncWindow(sws application.SyncWindows, sw *application.SyncWindow) int {
+	for i, w := range sws {
+		if w != nil && reflect.DeepEqual(*w, *sw) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// projectSyncWindowID returns the ID of a sync window, derived from its
+// attributes as sync windows have no name.
+func projectSyncWindowID(projectName string, sw *application.SyncWindow) string {
+	j, _ := json.Marshal(sw)
+	sum := sha256.Sum256(j)
+
+	return fmt.Sprintf("%s:%x", projectName, sum[:6])
+}
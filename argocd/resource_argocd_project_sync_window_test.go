@@ -0,0 +1,78 @@
+package argocd
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccArgoCDProjectSyncWindow(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectSyncWindow(name, "deny", "8h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("argocd_project_sync_window.deny", "id"),
+					resource.TestCheckResourceAttr("argocd_project_sync_window.deny", "kind", "deny"),
+					resource.TestCheckResourceAttr("argocd_project_sync_window.deny", "duration", "8h"),
+					resource.TestCheckResourceAttr("argocd_project_sync_window.deny", "timezone", "UTC"),
+					resource.TestCheckResourceAttr("argocd_project_sync_window.allow", "applications.#", "1"),
+					resource.TestCheckResourceAttr("argocd_project_sync_window.allow", "manual_sync", "true"),
+				),
+			},
+			{
+				Config: testAccArgoCDProjectSyncWindow(name, "allow", "4h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project_sync_window.deny", "kind", "allow"),
+					resource.TestCheckResourceAttr("argocd_project_sync_window.deny", "duration", "4h"),
+				),
+			},
+		},
+	})
+}
+
+func testAccArgoCDProjectSyncWindow(name, kind, duration string) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "test" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  manage_sync_windows = false
+
+  spec {
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "*"
+    }
+  }
+}
+
+resource "argocd_project_sync_window" "deny" {
+  project  = argocd_project.test.metadata[0].name
+  kind     = "%[2]s"
+  schedule = "10 1 * * *"
+  duration = "%[3]s"
+  clusters = ["in-cluster"]
+}
+
+resource "argocd_project_sync_window" "allow" {
+  project      = argocd_project.test.metadata[0].name
+  kind         = "allow"
+  schedule     = "0 22 * * *"
+  duration     = "1h"
+  applications = ["*"]
+  manual_sync  = true
+  timezone     = "Europe/London"
+}
+`, name, kind, duration)
+}
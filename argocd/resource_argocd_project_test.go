@@ -53,6 +53,12 @@ func TestAccArgoCDProject(t *testing.T) {
 				),
 				ExpectError: regexp.MustCompile("cannot parse timezone"),
 			},
+			{
+				Config: testAccArgoCDProjectClusterResourceWhitelistError(
+					"test-acc-" + acctest.RandString(10),
+				),
+				ExpectError: regexp.MustCompile("invalid group 'Apps_v1'"),
+			},
 			{
 				Config: testAccArgoCDProjectSimple(name),
 				Check: resource.TestCheckResourceAttrSet(
@@ -73,6 +79,31 @@ func TestAccArgoCDProject(t *testing.T) {
 						"argocd_project.simple",
 						"metadata.0.uid",
 					),
+					resource.TestCheckResourceAttr(
+						"argocd_project.simple",
+						"spec.0.orphaned_resources.0.ignore.#",
+						"3",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_project.simple",
+						"spec.0.namespace_resource_blacklist.#",
+						"2",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_project.simple",
+						"global_projects.#",
+						"0",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_project.simple",
+						"scoped_repositories.#",
+						"0",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_project.simple",
+						"scoped_clusters.#",
+						"0",
+					),
 					// TODO: check all possible attributes
 				),
 			},
@@ -101,13 +132,15 @@ func TestAccArgoCDProject(t *testing.T) {
 }
 
 func TestAccArgoCDProject_tokensCoexistence(t *testing.T) {
+	name := "test-acc-" + acctest.RandString(10)
+
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccArgoCDProjectCoexistenceWithTokenResource(
-					"test-acc-"+acctest.RandString(10),
+					name,
 					4,
 				),
 				Check: resource.ComposeTestCheckFunc(
@@ -141,6 +174,136 @@ func TestAccArgoCDProject_tokensCoexistence(t *testing.T) {
 					),
 				),
 			},
+			{
+				// Tokens are issued after the project has been read, hence
+				// they are only listed upon the next refresh
+				Config: testAccArgoCDProjectCoexistenceWithTokenResource(
+					name,
+					4,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_project.coexistence",
+						"role_tokens.#",
+						"5",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_project.coexistence",
+						"role_tokens.0.role",
+						"testrole",
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_project.coexistence",
+						"role_tokens.0.issued_at",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDProject_revokeRemovedRoleTokens(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectCoexistenceWithTokenResource(name, 2),
+				Check: resource.TestCheckResourceAttrSet(
+					"argocd_project_token.multiple.1",
+					"issued_at",
+				),
+			},
+			{
+				Config: testAccArgoCDProjectWithoutTokenRole(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_project.coexistence",
+						"spec.0.role.#",
+						"0",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_project.coexistence",
+						"revoke_removed_role_tokens",
+						"true",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDProject_manifest(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDProjectManifest(name, "other-project", false),
+				ExpectError: regexp.MustCompile(`manifest metadata.name "other-project" does not match the project name`),
+			},
+			{
+				Config: testAccArgoCDProjectManifest(name, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"argocd_project.manifest",
+						"metadata.0.uid",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_project.manifest",
+						"spec.#",
+						"0",
+					),
+					resource.TestCheckResourceAttrSet(
+						"argocd_project.manifest",
+						"manifest",
+					),
+				),
+			},
+			{
+				// Equivalent manifests must not produce diffs
+				Config:   testAccArgoCDProjectManifest(name, name, true),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccArgoCDProject_unmanagedRoles(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArgoCDProjectUnmanagedRolesInvalid(name),
+				ExpectError: regexp.MustCompile("roles cannot be set when manage_roles is disabled"),
+			},
+			{
+				Config: testAccArgoCDProjectUnmanagedRoles(name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project.unmanaged", "spec.0.role.#", "0"),
+					resource.TestCheckResourceAttrSet("argocd_project_role.unmanaged", "id"),
+				),
+			},
+			{
+				// Roles managed elsewhere must survive project updates
+				Config: testAccArgoCDProjectUnmanagedRoles(name, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_project.unmanaged", "spec.0.description", "second"),
+					resource.TestCheckResourceAttr("argocd_project.unmanaged", "spec.0.role.#", "0"),
+					resource.TestCheckResourceAttr("argocd_project_role.unmanaged", "policies.#", "1"),
+				),
+			},
+			{
+				Config:   testAccArgoCDProjectUnmanagedRoles(name, "second"),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -237,7 +400,11 @@ func TestAccArgoCDProjectWithSourceNamespaces(t *testing.T) {
 		ProviderFactories: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccArgoCDProjectWithSourceNamespaces(name),
+				Config:      testAccArgoCDProjectWithSourceNamespaces(name, "team-[a-"),
+				ExpectError: regexp.MustCompile("invalid glob pattern"),
+			},
+			{
+				Config: testAccArgoCDProjectWithSourceNamespaces(name, "*"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(
 						"argocd_project.simple",
@@ -304,6 +471,10 @@ resource "argocd_project" "simple" {
       group = "networking.k8s.io"
       kind  = "Ingress"
     }
+    namespace_resource_blacklist {
+      group = ""
+      kind  = "LimitRange"
+    }
     namespace_resource_whitelist {
       group = "*"
       kind  = "*"
@@ -320,6 +491,11 @@ resource "argocd_project" "simple" {
         kind  = "Deployment"
         name  = "ignored2"
       }
+      ignore {
+        group = "*.example.com"
+        kind  = "*"
+        name  = "ignored-*"
+      }
     }
     sync_window {
       kind = "allow"
@@ -441,6 +617,133 @@ resource "argocd_project_token" "coexistence_testrole_exp" {
 	`, name, name, name, count)
 }
 
+func testAccArgoCDProjectWithoutTokenRole(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "coexistence" {
+  metadata {
+    name        = "%s"
+    namespace   = "argocd"
+  }
+
+  spec {
+    description = "coexistence"
+    destination {
+	   server    = "https://kubernetes.default.svc"
+	   namespace = "*"
+    }
+    source_repos = ["*"]
+  }
+}
+	`, name)
+}
+
+func testAccArgoCDProjectManifest(name, manifestName string, asJSON bool) string {
+	manifest := fmt.Sprintf(`<<EOT
+apiVersion: argoproj.io/v1alpha1
+kind: AppProject
+metadata:
+  name: %[1]s
+  namespace: argocd
+spec:
+  description: project from manifest
+  sourceRepos:
+    - '*'
+  destinations:
+    - server: https://kubernetes.default.svc
+      namespace: default
+  roles:
+    - name: ci
+      policies:
+        - p, proj:%[1]s:ci, applications, sync, %[1]s/*, allow
+EOT`, manifestName)
+
+	if asJSON {
+		manifest = fmt.Sprintf(`jsonencode({
+    apiVersion = "argoproj.io/v1alpha1"
+    kind       = "AppProject"
+    metadata   = { name = "%[1]s" }
+    spec = {
+      roles = [{
+        name     = "ci"
+        policies = ["p, proj:%[1]s:ci, applications, sync, %[1]s/*, allow"]
+      }]
+      destinations = [{ namespace = "default", server = "https://kubernetes.default.svc" }]
+      sourceRepos  = ["*"]
+      description  = "project from manifest"
+    }
+  })`, manifestName)
+	}
+
+	return fmt.Sprintf(`
+resource "argocd_project" "manifest" {
+  metadata {
+    name      = "%s"
+    namespace = "argocd"
+  }
+
+  manifest = %s
+}
+	`, name, manifest)
+}
+
+func testAccArgoCDProjectUnmanagedRoles(name, description string) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "unmanaged" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  manage_roles = false
+
+  spec {
+    description  = "%[2]s"
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "*"
+    }
+  }
+}
+
+resource "argocd_project_role" "unmanaged" {
+  project  = argocd_project.unmanaged.metadata[0].name
+  name     = "external"
+  policies = [
+    "p, proj:%[1]s:external, applications, get, %[1]s/*, allow",
+  ]
+}
+	`, name, description)
+}
+
+func testAccArgoCDProjectUnmanagedRolesInvalid(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "unmanaged" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  manage_roles = false
+
+  spec {
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "*"
+    }
+
+    role {
+      name     = "testrole"
+      policies = ["p, proj:%[1]s:testrole, applications, get, %[1]s/*, allow"]
+    }
+  }
+}
+	`, name)
+}
+
 func testAccArgoCDProjectPolicyError(name string) string {
 	return fmt.Sprintf(`
 resource "argocd_project" "failure" {
@@ -493,6 +796,30 @@ resource "argocd_project" "failure" {
 	`, name, name, name)
 }
 
+func testAccArgoCDProjectClusterResourceWhitelistError(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_project" "failure" {
+  metadata {
+    name        = "%s"
+    namespace   = "argocd"
+  }
+
+  spec {
+    description = "expected cluster resource whitelist failure"
+    destination {
+	   server    = "https://kubernetes.default.svc"
+	   namespace = "*"
+    }
+    source_repos = ["*"]
+    cluster_resource_whitelist {
+      group = "Apps_v1"
+      kind  = "*"
+    }
+  }
+}
+	`, name)
+}
+
 func testAccArgoCDProjectSyncWindowScheduleError(name string) string {
 	return fmt.Sprintf(`
 resource "argocd_project" "failure" {
@@ -759,7 +1086,7 @@ func testAccArgoCDProjectWithExecLogsRolePolicy(name string) string {
 	`, name)
 }
 
-func testAccArgoCDProjectWithSourceNamespaces(name string) string {
+func testAccArgoCDProjectWithSourceNamespaces(name, sourceNamespace string) string {
 	return fmt.Sprintf(`
 resource "argocd_project" "simple" {
   metadata {
@@ -776,7 +1103,7 @@ resource "argocd_project" "simple" {
   spec {
     description  = "simple project"
     source_repos = ["*"]
-    source_namespaces = ["*"]
+    source_namespaces = ["%s"]
 
     destination {
       server    = "https://kubernetes.default.svc"
@@ -796,7 +1123,7 @@ resource "argocd_project" "simple" {
     }
   }
 }
-	`, name)
+	`, name, sourceNamespace)
 }
 
 func testAccArgoCDProjectSyncWindowTimezoneError(name string) string {
@@ -834,3 +1161,78 @@ resource "argocd_project" "failure" {
 }
   `, name, name, name)
 }
+
+func TestAccArgoCDProject_deleteWithApplications(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectWithApplication(name, true),
+				Check: resource.TestCheckResourceAttrSet(
+					"argocd_application.test",
+					"metadata.0.uid",
+				),
+			},
+			{
+				Config:      testAccArgoCDProjectWithApplication(name, false),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("project %s still contains 1 application", name)),
+			},
+		},
+	})
+}
+
+func testAccArgoCDProjectWithApplication(name string, withProject bool) string {
+	project := fmt.Sprintf(`
+resource "argocd_project" "test" {
+  metadata {
+    name      = "%s"
+    namespace = "argocd"
+  }
+
+  spec {
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "*"
+    }
+  }
+}
+`, name)
+
+	dependsOn := "depends_on = [argocd_project.test]"
+
+	if !withProject {
+		project = ""
+		dependsOn = ""
+	}
+
+	return project + fmt.Sprintf(`
+resource "argocd_application" "test" {
+  metadata {
+    name      = "%[1]s"
+    namespace = "argocd"
+  }
+
+  spec {
+    project = "%[1]s"
+
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps.git"
+      path            = "guestbook"
+      target_revision = "HEAD"
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "default"
+    }
+  }
+
+  %[2]s
+}
+`, name, dependsOn)
+}
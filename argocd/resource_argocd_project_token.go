@@ -23,6 +23,18 @@ func resourceArgoCDProjectToken() *schema.Resource {
 		UpdateContext: resourceArgoCDProjectTokenUpdate,
 		DeleteContext: resourceArgoCDProjectTokenDelete,
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			// Validate renewal settings at plan time rather than upon token issuance
+			if rb, ok := d.GetOk("renew_before"); ok {
+				if ei, ok := d.GetOk("expires_in"); ok {
+					renewBeforeDuration, rbErr := time.ParseDuration(rb.(string))
+					expiresInDuration, eiErr := time.ParseDuration(ei.(string))
+
+					if rbErr == nil && eiErr == nil && renewBeforeDuration > expiresInDuration {
+						return fmt.Errorf("renew_before (%d) cannot be greater than expires_in (%d) for project %s", int64(renewBeforeDuration.Seconds()), int64(expiresInDuration.Seconds()), d.Get("project").(string))
+					}
+				}
+			}
+
 			ia := d.Get("issued_at").(string)
 			if ia == "" {
 				// Blank issued_at indicates a new token - nothing to do here
@@ -109,7 +121,7 @@ func resourceArgoCDProjectToken() *schema.Resource {
 			},
 			"expires_in": {
 				Type:         schema.TypeString,
-				Description:  "Duration before the token will expire. Valid time units are `ns`, `us` (or `µs`), `ms`, `s`, `m`, `h`. E.g. `12h`, `7d`. Default: No expiration.",
+				Description:  "Duration before the token will expire. Valid time units are `ns`, `us` (or `µs`), `ms`, `s`, `m`, `h`. E.g. `12h`, `168h`. Default: No expiration.",
 				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validateDuration,
@@ -122,17 +134,31 @@ func resourceArgoCDProjectToken() *schema.Resource {
 			},
 			"renew_before": {
 				Type:         schema.TypeString,
-				Description:  "Duration to control token silent regeneration based on remaining token lifetime. If `expires_in` is set, Terraform will regenerate the token if `expires_at - currentDate < renew_before`. Valid time units are `ns`, `us` (or `µs`), `ms`, `s`, `m`, `h`.",
+				Description:  "Duration to control token silent regeneration based on remaining token lifetime. If `expires_in` is set, Terraform will regenerate the token if `expires_at - currentDate < renew_before`, which is evaluated at plan time so that plans show the token will be rotated. Must not be greater than `expires_in`, which is also validated at plan time. Valid time units are `ns`, `us` (or `µs`), `ms`, `s`, `m`, `h`. E.g. `168h`.",
 				Optional:     true,
 				ValidateFunc: validateDuration,
 				RequiredWith: []string{"expires_in"},
 			},
+			"token_id": {
+				Type:        schema.TypeString,
+				Description: "Identifier of the token, used as its `jti` claim. Setting a meaningful identifier (e.g. the name of the pipeline using the token) allows identifying the token in audit logs and in the output of `argocd proj role list-tokens`. Must be unique within the role, hence tokens with an explicit identifier cannot be replaced using `create_before_destroy`. Defaults to a random UUID.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
 			"description": {
 				Type:        schema.TypeString,
 				Description: "Description of the token.",
 				Optional:    true,
 				ForceNew:    true,
 			},
+			"keepers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary map of values that, when changed, will trigger the regeneration of the token. E.g. the policies and groups of the role, so that tokens do not retain permissions that have been removed from it.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"jwt": {
 				Type:        schema.TypeString,
 				Description: "The raw JWT.",
@@ -176,6 +202,10 @@ func resourceArgoCDProjectTokenCreate(ctx context.Context, d *schema.ResourceDat
 		opts.Description = d.(string)
 	}
 
+	if id, ok := d.GetOk("token_id"); ok {
+		opts.Id = id.(string)
+	}
+
 	var expiresIn int64
 
 	_expiresIn, expiresInOk := d.GetOk("expires_in")
@@ -270,6 +300,10 @@ func resourceArgoCDProjectTokenCreate(ctx context.Context, d *schema.ResourceDat
 
 	d.SetId(claims.ID)
 
+	if err = d.Set("token_id", claims.ID); err != nil {
+		return errorToDiagnostics(fmt.Sprintf("token claims ID for project %s could not be persisted to state", projectName), err)
+	}
+
 	return resourceArgoCDProjectTokenRead(ctx, d, meta)
 }
 
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
@@ -107,6 +108,24 @@ func TestAccArgoCDProjectToken_RenewBefore(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDProjectToken_RenewBeforeValidation(t *testing.T) {
+	expiresInDuration, _ := time.ParseDuration("30s")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// renew_before is validated against expires_in at plan time,
+				// before any token is issued
+				Config:      testAccArgoCDProjectTokenRenewBeforeFailure(expiresInDuration),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("renew_before .* cannot be greater than expires_in .*"),
+			},
+		},
+	})
+}
+
 func TestAccArgoCDProjectToken_RenewAfter(t *testing.T) {
 	resourceName := "argocd_project_token.renew_after"
 	renewAfterSeconds := 30
@@ -139,6 +158,52 @@ func TestAccArgoCDProjectToken_RenewAfter(t *testing.T) {
 	})
 }
 
+func TestAccArgoCDProjectToken_Keepers(t *testing.T) {
+	resourceName := "argocd_project_token.keepers"
+
+	var tokenID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectTokenKeepers("get"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "keepers.%", "1"),
+					testCheckTokenID(resourceName, &tokenID, false),
+				),
+			},
+			{
+				Config: testAccArgoCDProjectTokenKeepers("sync"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTokenID(resourceName, &tokenID, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccArgoCDProjectToken_TokenID(t *testing.T) {
+	resourceName := "argocd_project_token.token_id"
+	tokenID := acctest.RandomWithPrefix("test-acc-pipeline")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDProjectTokenTokenID(tokenID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", tokenID),
+					resource.TestCheckResourceAttr(resourceName, "token_id", tokenID),
+					resource.TestCheckResourceAttr(resourceName, "description", "issued for "+tokenID),
+				),
+			},
+		},
+	})
+}
+
 func testAccArgoCDProjectTokenSimple() string {
 	return `
 resource "argocd_project_token" "simple" {
@@ -223,6 +288,61 @@ resource "argocd_project_token" "renew_after" {
 `, renewAfter)
 }
 
+func testAccArgoCDProjectTokenKeepers(action string) string {
+	return fmt.Sprintf(`
+resource "argocd_project_role" "keepers" {
+  project  = "myproject1"
+  name     = "test-role-keepers"
+  policies = [
+    "p, proj:myproject1:test-role-keepers, applications, %s, myproject1/*, allow",
+  ]
+}
+
+resource "argocd_project_token" "keepers" {
+  project = argocd_project_role.keepers.project
+  role    = argocd_project_role.keepers.name
+
+  keepers = {
+    policies = join(";", argocd_project_role.keepers.policies)
+  }
+}
+`, action)
+}
+
+// testCheckTokenID records the ID of the token in id, or, if changed is true,
+// checks that it differs from the previously recorded one.
+func testAccArgoCDProjectTokenTokenID(tokenID string) string {
+	return fmt.Sprintf(`
+resource "argocd_project_token" "token_id" {
+  project     = "myproject1"
+  role        = "test-role1234"
+  token_id    = "%[1]s"
+  description = "issued for %[1]s"
+}
+`, tokenID)
+}
+
+func testCheckTokenID(resourceName string, id *string, changed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("token ID is not set")
+		}
+
+		if changed && rs.Primary.ID == *id {
+			return fmt.Errorf("testCheckTokenID: token %s has not been regenerated", *id)
+		}
+
+		*id = rs.Primary.ID
+
+		return nil
+	}
+}
+
 func testCheckTokenIssuedAt(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -74,6 +74,23 @@ func TestAccArgoCDRepository_Helm(t *testing.T) {
 					),
 				),
 			},
+			{
+				// Project-scoped repositories are only listed by the project
+				// upon the refresh following their creation
+				Config: testAccArgoCDRepositoryHelmProjectScoped(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"argocd_project.simple",
+						"scoped_repositories.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"argocd_project.simple",
+						"scoped_repositories.0",
+						"https://helm.nginx.com/stable",
+					),
+				),
+			},
 		},
 	})
 }
@@ -1367,8 +1367,14 @@ func applicationSpecSchemaV4(allOptional bool) *schema.Schema {
 													},
 													"path": {
 														Type:        schema.TypeString,
-														Description: "Path to the file containing the values for the Helm parameter.",
-														Required:    true,
+														Description: "Path to the file containing the values for the Helm parameter. Exactly one of `path` or `content` must be set.",
+														Optional:    true,
+													},
+													"content": {
+														Type:        schema.TypeString,
+														Description: "Inline content of the file for the Helm parameter, passed to Helm as a string parameter (with commas and backslashes escaped) so that it does not need to be committed to the repository. Exactly one of `path` or `content` must be set, and the name must not be used by a `parameter` block, as both are stored as Helm parameters of the `Application`. **Note**: the content is only hidden from Terraform outputs, it is stored in clear text in the spec of the `Application` and is thus readable by anyone allowed to get the application (e.g. through the ArgoCD UI, API or `kubectl`). Secrets should rather be provided through a secret management plugin or a Kubernetes secret.",
+														Optional:    true,
+														Sensitive:   true,
 													},
 												},
 											},
@@ -1436,6 +1442,124 @@ func applicationSpecSchemaV4(allOptional bool) *schema.Schema {
 											Elem:         &schema.Schema{Type: schema.TypeString},
 											ValidateFunc: validateMetadataAnnotations,
 										},
+										"common_labels_force": {
+											Type:        schema.TypeBool,
+											Description: "Whether to force applying `common_labels` to resources, overriding existing labels with the same keys.",
+											Optional:    true,
+										},
+										"common_annotations_force": {
+											Type:        schema.TypeBool,
+											Description: "Whether to force applying `common_annotations` to resources, overriding existing annotations with the same keys.",
+											Optional:    true,
+										},
+										"label_without_selector": {
+											Type:        schema.TypeBool,
+											Description: "Whether to apply `common_labels` to resource templates and selectors. If set to `true`, labels are only applied to resource metadata, which avoids breaking immutable selectors.",
+											Optional:    true,
+										},
+										"namespace": {
+											Type:        schema.TypeString,
+											Description: "Namespace that will override the namespace set in the kustomization (Kustomize's `namespace`).",
+											Optional:    true,
+										},
+										"components": {
+											Type:        schema.TypeList,
+											Description: "List of relative paths to Kustomize components to add to the kustomization before building.",
+											Optional:    true,
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+										},
+										"patches": {
+											Type:        schema.TypeList,
+											Description: "List of [Kustomize patches](https://kubectl.docs.kubernetes.io/references/kustomize/kustomization/patches/) to apply.",
+											Optional:    true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"patch": {
+														Type:        schema.TypeString,
+														Description: "Inline Kustomize patch to apply.",
+														Optional:    true,
+													},
+													"path": {
+														Type:        schema.TypeString,
+														Description: "File path to a patch to apply, relative to the kustomization.",
+														Optional:    true,
+													},
+													"options": {
+														Type:        schema.TypeMap,
+														Description: "Additional [options](https://kubectl.docs.kubernetes.io/references/kustomize/kustomization/patches/#name-and-kind-changes).",
+														Optional:    true,
+														Elem:        &schema.Schema{Type: schema.TypeBool},
+													},
+													"target": {
+														Type:        schema.TypeList,
+														Description: "Target(s) to patch.",
+														Optional:    true,
+														MaxItems:    1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"group": {
+																	Type:        schema.TypeString,
+																	Description: "The Kubernetes resource Group to match for.",
+																	Optional:    true,
+																},
+																"version": {
+																	Type:        schema.TypeString,
+																	Description: "The Kubernetes resource Version to match for.",
+																	Optional:    true,
+																},
+																"kind": {
+																	Type:        schema.TypeString,
+																	Description: "The Kubernetes resource Kind to match for.",
+																	Optional:    true,
+																},
+																"name": {
+																	Type:        schema.TypeString,
+																	Description: "The Kubernetes resource Name to match for.",
+																	Optional:    true,
+																},
+																"namespace": {
+																	Type:        schema.TypeString,
+																	Description: "The Kubernetes resource Namespace to match for.",
+																	Optional:    true,
+																},
+																"label_selector": {
+																	Type:        schema.TypeString,
+																	Description: "Label selector to use when matching the Kubernetes resource.",
+																	Optional:    true,
+																},
+																"annotation_selector": {
+																	Type:        schema.TypeString,
+																	Description: "Annotation selector to use when matching the Kubernetes resource.",
+																	Optional:    true,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+										"replicas": {
+											Type:        schema.TypeList,
+											Description: "List of Kustomize replica count overrides.",
+											Optional:    true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"name": {
+														Type:        schema.TypeString,
+														Description: "Name of the resource whose replica count should be overridden.",
+														Required:    true,
+													},
+													"count": {
+														Type:         schema.TypeString,
+														Description:  "Number of replicas.",
+														Required:     true,
+														ValidateFunc: validateInteger,
+													},
+												},
+											},
+										},
 									},
 								},
 							},
@@ -1444,15 +1568,17 @@ func applicationSpecSchemaV4(allOptional bool) *schema.Schema {
 								Description: "Path/directory specific options.",
 								DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
 									// Avoid drift when recurse is explicitly set to false
-									// Also ignore the directory node if both recurse & jsonnet are not set or ignored
+									// Also ignore the directory node if recurse, jsonnet, include & exclude are not set or ignored
 									if k == "spec.0.source.0.directory.0.recurse" && oldValue == "" && newValue == "false" {
 										return true
 									}
 									if k == "spec.0.source.0.directory.#" {
 										_, hasRecurse := d.GetOk("spec.0.source.0.directory.0.recurse")
 										_, hasJsonnet := d.GetOk("spec.0.source.0.directory.0.jsonnet")
+										_, hasInclude := d.GetOk("spec.0.source.0.directory.0.include")
+										_, hasExclude := d.GetOk("spec.0.source.0.directory.0.exclude")
 
-										if !hasJsonnet && !hasRecurse {
+										if !hasJsonnet && !hasRecurse && !hasInclude && !hasExclude {
 											return true
 										}
 									}
@@ -1580,6 +1706,39 @@ func applicationSpecSchemaV4(allOptional bool) *schema.Schema {
 												},
 											},
 										},
+										"parameter": {
+											Type:        schema.TypeList,
+											Description: "Parameters passed to the plugin. Only one of `string`, `map` or `array` should be set for each parameter.",
+											Optional:    true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"name": {
+														Type:        schema.TypeString,
+														Description: "Name of the parameter.",
+														Required:    true,
+													},
+													"string": {
+														Type:        schema.TypeString,
+														Description: "Value of a string type parameter.",
+														Optional:    true,
+													},
+													"map": {
+														Type:        schema.TypeMap,
+														Description: "Value of a map type parameter.",
+														Optional:    true,
+														Elem:        &schema.Schema{Type: schema.TypeString},
+													},
+													"array": {
+														Type:        schema.TypeList,
+														Description: "Value of an array type parameter.",
+														Optional:    true,
+														Elem: &schema.Schema{
+															Type: schema.TypeString,
+														},
+													},
+												},
+											},
+										},
 									},
 								},
 							},
@@ -1603,10 +1762,11 @@ func applicationSpecSchemaV4(allOptional bool) *schema.Schema {
 						if k == "spec.0.sync_policy.#" {
 							_, hasAutomated := d.GetOk("spec.0.sync_policy.0.automated")
 							_, hasSyncOptions := d.GetOk("spec.0.sync_policy.0.sync_options")
+							_, hasOptions := d.GetOk("spec.0.sync_policy.0.options")
 							_, hasRetry := d.GetOk("spec.0.sync_policy.0.retry")
 							_, hasManagedNamespaceMetadata := d.GetOk("spec.0.sync_policy.0.managed_namespace_metadata")
 
-							if !hasAutomated && !hasSyncOptions && !hasRetry && !hasManagedNamespaceMetadata {
+							if !hasAutomated && !hasSyncOptions && !hasOptions && !hasRetry && !hasManagedNamespaceMetadata {
 								return true
 							}
 						}
@@ -1644,8 +1804,53 @@ func applicationSpecSchemaV4(allOptional bool) *schema.Schema {
 								Description: "List of sync options. More info: https://argo-cd.readthedocs.io/en/stable/user-guide/sync-options/.",
 								Optional:    true,
 								Elem: &schema.Schema{
-									Type: schema.TypeString,
-									// TODO: add a validator
+									Type:         schema.TypeString,
+									ValidateFunc: validateSyncOption,
+								},
+							},
+							"options": {
+								Type:        schema.TypeList,
+								Description: "Typed alternative to `sync_options`. Options set here are merged with `sync_options`. More info: https://argo-cd.readthedocs.io/en/stable/user-guide/sync-options/.",
+								Optional:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"create_namespace": {
+											Type:        schema.TypeBool,
+											Description: "Whether to create the destination namespace if it does not exist (`CreateNamespace=true`).",
+											Optional:    true,
+										},
+										"server_side_apply": {
+											Type:        schema.TypeBool,
+											Description: "Whether to use Kubernetes server-side apply (`ServerSideApply=true`).",
+											Optional:    true,
+										},
+										"apply_out_of_sync_only": {
+											Type:        schema.TypeBool,
+											Description: "Whether to only sync resources that are out of sync (`ApplyOutOfSyncOnly=true`).",
+											Optional:    true,
+										},
+										"prune_last": {
+											Type:        schema.TypeBool,
+											Description: "Whether to prune resources as the final, implicit wave of a sync operation (`PruneLast=true`).",
+											Optional:    true,
+										},
+										"respect_ignore_differences": {
+											Type:        schema.TypeBool,
+											Description: "Whether to also take `ignore_difference` into account during syncs (`RespectIgnoreDifferences=true`).",
+											Optional:    true,
+										},
+										"fail_on_shared_resource": {
+											Type:        schema.TypeBool,
+											Description: "Whether to fail the sync when a resource is already managed by another application (`FailOnSharedResource=true`).",
+											Optional:    true,
+										},
+										"replace": {
+											Type:        schema.TypeBool,
+											Description: "Whether to use `kubectl replace`/`kubectl create` instead of `kubectl apply` (`Replace=true`).",
+											Optional:    true,
+										},
+									},
 								},
 							},
 							"retry": {
@@ -1656,9 +1861,10 @@ func applicationSpecSchemaV4(allOptional bool) *schema.Schema {
 								Elem: &schema.Resource{
 									Schema: map[string]*schema.Schema{
 										"limit": {
-											Type:        schema.TypeString,
-											Description: "Maximum number of attempts for retrying a failed sync. If set to 0, no retries will be performed.",
-											Optional:    true,
+											Type:         schema.TypeString,
+											Description:  "Maximum number of attempts for retrying a failed sync. If set to 0, no retries will be performed.",
+											Optional:     true,
+											ValidateFunc: validateInteger,
 										},
 										"backoff": {
 											Type:        schema.TypeSet,
@@ -1668,19 +1874,22 @@ func applicationSpecSchemaV4(allOptional bool) *schema.Schema {
 											Elem: &schema.Resource{
 												Schema: map[string]*schema.Schema{
 													"duration": {
-														Type:        schema.TypeString,
-														Description: "Duration is the amount to back off. Default unit is seconds, but could also be a duration (e.g. `2m`, `1h`), as a string.",
-														Optional:    true,
+														Type:         schema.TypeString,
+														Description:  "Duration is the amount to back off. Default unit is seconds, but could also be a duration (e.g. `2m`, `1h`), as a string.",
+														Optional:     true,
+														ValidateFunc: validateBackoffDuration,
 													},
 													"factor": {
-														Type:        schema.TypeString,
-														Description: "Factor to multiply the base duration after each failed retry.",
-														Optional:    true,
+														Type:         schema.TypeString,
+														Description:  "Factor to multiply the base duration after each failed retry.",
+														Optional:     true,
+														ValidateFunc: validatePositiveInteger,
 													},
 													"max_duration": {
-														Type:        schema.TypeString,
-														Description: "Maximum amount of time allowed for the backoff strategy. Default unit is seconds, but could also be a duration (e.g. `2m`, `1h`), as a string.",
-														Optional:    true,
+														Type:         schema.TypeString,
+														Description:  "Maximum amount of time allowed for the backoff strategy. Default unit is seconds, but could also be a duration (e.g. `2m`, `1h`), as a string.",
+														Optional:     true,
+														ValidateFunc: validateBackoffDuration,
 													},
 												},
 											},
@@ -1759,6 +1968,15 @@ func applicationSpecSchemaV4(allOptional bool) *schema.Schema {
 									Type: schema.TypeString,
 								},
 							},
+							"managed_fields_managers": {
+								Type:        schema.TypeSet,
+								Description: "List of external controller manager names whose changes to fields should be ignored.",
+								Set:         schema.HashString,
+								Optional:    true,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
 						},
 					},
 				},
@@ -1891,6 +2109,17 @@ func applicationStatusSchema() *schema.Schema {
 								Description: "Count of operation retries.",
 								Computed:    true,
 							},
+							"revision": {
+								Type:        schema.TypeString,
+								Description: "Revision the last sync operation synced the application to.",
+								Computed:    true,
+							},
+							"revisions": {
+								Type:        schema.TypeList,
+								Description: "Revisions the last sync operation synced the application sources to, for applications with multiple sources.",
+								Computed:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
 							"started_at": {
 								Type:        schema.TypeString,
 								Description: "Time of operation start.",
@@ -2,8 +2,14 @@ package argocd
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// generatorSchemaLevel is the depth of the generator schema: a top level
+// generator, a matrix/merge child (which may itself be a matrix or merge) and
+// the terminal generators of the latter. This matches the single level of
+// combination generator nesting supported by ArgoCD, e.g. matrix of merge of
+// git.
 const generatorSchemaLevel = 3
 
 func applicationSetSpecSchemaV0() *schema.Schema {
@@ -17,13 +23,13 @@ func applicationSetSpecSchemaV0() *schema.Schema {
 			Schema: map[string]*schema.Schema{
 				"ignore_application_differences": {
 					Type:        schema.TypeList,
-					Description: "Application Set [ignoreApplicationDifferences](https://argo-cd.readthedocs.io/en/stable/operator-manual/applicationset/Controlling-Resource-Modification/#ignore-certain-changes-to-applications).",
+					Description: "Application Set [ignoreApplicationDifferences](https://argo-cd.readthedocs.io/en/stable/operator-manual/applicationset/Controlling-Resource-Modification/#ignore-certain-changes-to-applications), i.e. fields of the generated applications that the ApplicationSet controller should not overwrite, e.g. when they are managed by another controller. Requires ArgoCD 2.9.0 or above.",
 					Optional:    true,
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"json_pointers": {
 								Type:        schema.TypeSet,
-								Description: "Json pointers to ignore differences",
+								Description: "List of JSONPaths strings targeting the fields of the generated applications to ignore.",
 								Optional:    true,
 								Elem: &schema.Schema{
 									Type: schema.TypeString,
@@ -31,7 +37,7 @@ func applicationSetSpecSchemaV0() *schema.Schema {
 							},
 							"jq_path_expressions": {
 								Type:        schema.TypeSet,
-								Description: "jq path to ignore differences",
+								Description: "List of JQ path expression strings targeting the fields of the generated applications to ignore.",
 								Optional:    true,
 								Elem: &schema.Schema{
 									Type: schema.TypeString,
@@ -39,7 +45,7 @@ func applicationSetSpecSchemaV0() *schema.Schema {
 							},
 							"name": {
 								Type:        schema.TypeString,
-								Description: "name",
+								Description: "Name of the generated application the differences apply to. Applies to all generated applications if empty.",
 								Optional:    true,
 							},
 						},
@@ -51,22 +57,55 @@ func applicationSetSpecSchemaV0() *schema.Schema {
 					Description: "Enable use of [Go Text Template](https://pkg.go.dev/text/template).",
 					Optional:    true,
 				},
+				"go_template_options": {
+					Type:        schema.TypeSet,
+					Description: "Optional list of [Go Templating Options](https://pkg.go.dev/text/template#Template.Option), e.g. `missingkey=error` to fail on missing generator parameters instead of rendering them as `<no value>`. Only relevant if `go_template` is true.",
+					Optional:    true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{"missingkey=default", "missingkey=invalid", "missingkey=zero", "missingkey=error"}, false),
+					},
+				},
+				"preserved_fields": {
+					Type:        schema.TypeList,
+					Description: "[Preserved fields](https://argo-cd.readthedocs.io/en/stable/operator-manual/applicationset/Controlling-Resource-Modification/#preserving-changes-made-to-an-applications-annotations-and-labels) of the generated applications, i.e. annotations and labels which are not overwritten by the ApplicationSet controller when added out-of-band.",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"annotations": {
+								Type:        schema.TypeList,
+								Description: "Annotation keys of the generated applications to preserve.",
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+							"labels": {
+								Type:        schema.TypeList,
+								Description: "Label keys of the generated applications to preserve.",
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
 				"strategy": {
 					Type:        schema.TypeList,
-					Description: "[Progressive Sync](https://argo-cd.readthedocs.io/en/stable/operator-manual/applicationset/Progressive-Syncs/) strategy",
+					Description: "[Progressive Sync](https://argo-cd.readthedocs.io/en/stable/operator-manual/applicationset/Progressive-Syncs/) strategy. Note that progressive syncs are an alpha feature which must be enabled on the ApplicationSet controller, otherwise the strategy is ignored.",
 					Optional:    true,
 					MaxItems:    1,
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"type": {
-								Type:        schema.TypeString,
-								Description: "Type of progressive sync.",
-								Required:    true,
+								Type:         schema.TypeString,
+								Description:  "Type of progressive sync. Valid values are `AllAtOnce` and `RollingSync`.",
+								Required:     true,
+								ValidateFunc: validation.StringInSlice([]string{"AllAtOnce", "RollingSync"}, false),
 							},
 							"rolling_sync": {
 								Type:        schema.TypeList,
 								Description: "Update strategy allowing you to group Applications by labels present on the generated Application resources. When the ApplicationSet changes, the changes will be applied to each group of Application resources sequentially.",
 								Optional:    true,
+								MaxItems:    1,
 								Elem: &schema.Resource{
 									Schema: map[string]*schema.Schema{
 										"step": {
@@ -105,9 +144,10 @@ func applicationSetSpecSchemaV0() *schema.Schema {
 								Optional:    true,
 							},
 							"applications_sync": {
-								Type:        schema.TypeString,
-								Description: "Represents the policy applied on the generated applications. Possible values are create-only, create-update, create-delete, and sync.",
-								Optional:    true,
+								Type:         schema.TypeString,
+								Description:  "Represents the policy applied on the generated applications. Possible values are `create-only`, `create-update`, `create-delete`, and `sync`. Note that the ApplicationSet controller only honors this policy if policy override is enabled on the controller, which is the default unless a controller-wide policy is configured.",
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"create-only", "create-update", "create-delete", "sync"}, false),
 							},
 						},
 					},
@@ -120,6 +160,11 @@ func applicationSetSpecSchemaV0() *schema.Schema {
 					MaxItems:    1,
 					Elem:        applicationSetTemplateResource(false),
 				},
+				"template_patch": {
+					Type:        schema.TypeString,
+					Description: "[Template patch](https://argo-cd.readthedocs.io/en/stable/operator-manual/applicationset/Template/#template-patch) applied to the generated applications after rendering the template, as a YAML or JSON string. Allows templating fields which cannot be templated within `template`, e.g. conditionally over generator parameters. Requires `go_template` to be enabled.",
+					Optional:    true,
+				},
 			},
 		},
 	}
@@ -145,6 +190,7 @@ func generatorResourceV0(level int) *schema.Resource {
 				"list":                      applicationSetListGeneratorSchemaV0(),
 				"matrix":                    applicationSetMatrixGeneratorSchemaV0(level),
 				"merge":                     applicationSetMergeGeneratorSchemaV0(level),
+				"plugin":                    applicationSetPluginGeneratorSchemaV0(),
 				"pull_request":              applicationSetPullRequestGeneratorSchemaV0(),
 				"scm_provider":              applicationSetSCMProviderGeneratorSchemaV0(),
 				"selector": {
@@ -166,6 +212,7 @@ func generatorResourceV0(level int) *schema.Resource {
 			"clusters":                  applicationSetClustersGeneratorSchemaV0(),
 			"git":                       applicationSetGitGeneratorSchemaV0(),
 			"list":                      applicationSetListGeneratorSchemaV0(),
+			"plugin":                    applicationSetPluginGeneratorSchemaV0(),
 			"pull_request":              applicationSetPullRequestGeneratorSchemaV0(),
 			"scm_provider":              applicationSetSCMProviderGeneratorSchemaV0(),
 			"selector": {
@@ -184,7 +231,7 @@ func generatorResourceV0(level int) *schema.Resource {
 func applicationSetClustersGeneratorSchemaV0() *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeList,
-		Description: "The [cluster generator](https://argo-cd.readthedocs.io/en/stable/operator-manual/applicationset/Generators-Cluster/) produces parameters based on the list of items found within the cluster secret.",
+		Description: "The [cluster generator](https://argo-cd.readthedocs.io/en/stable/operator-manual/applicationset/Generators-Cluster/) produces parameters based on the list of items found within the cluster secret. Cluster generators are re-evaluated whenever a cluster secret changes, hence do not support `requeue_after_seconds`.",
 		Optional:    true,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
@@ -248,9 +295,10 @@ func applicationSetClusterDecisionResourceGeneratorSchemaV0() *schema.Schema {
 					},
 				},
 				"requeue_after_seconds": {
-					Type:        schema.TypeString,
-					Description: "How often to check for changes (in seconds). Default: 3min.",
-					Optional:    true,
+					Type:         schema.TypeString,
+					Description:  "How often to check for changes (in seconds). Default: 3min.",
+					Optional:     true,
+					ValidateFunc: validatePositiveInteger,
 				},
 				"template": {
 					Type:        schema.TypeList,
@@ -326,6 +374,12 @@ func applicationSetGitGeneratorSchemaV0() *schema.Schema {
 					Description: "Prefix for all path-related parameter names.",
 					Optional:    true,
 				},
+				"requeue_after_seconds": {
+					Type:         schema.TypeString,
+					Description:  "How often to check for changes (in seconds). Default: 3min.",
+					Optional:     true,
+					ValidateFunc: validatePositiveInteger,
+				},
 				"template": {
 					Type:        schema.TypeList,
 					Description: "Generator template. Used to override the values of the spec-level template.",
@@ -333,6 +387,12 @@ func applicationSetGitGeneratorSchemaV0() *schema.Schema {
 					MaxItems:    1,
 					Elem:        applicationSetTemplateResource(true),
 				},
+				"values": {
+					Type:        schema.TypeMap,
+					Description: "Arbitrary string key-value pairs to pass to the template via the values field of the git generator. Values can reference the parameters generated by the git generator, e.g. `path.basename` (within template delimiters).",
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
 			},
 		},
 	}
@@ -347,13 +407,18 @@ func applicationSetListGeneratorSchemaV0() *schema.Schema {
 			Schema: map[string]*schema.Schema{
 				"elements": {
 					Type:        schema.TypeList,
-					Description: "List of key/value pairs to pass as parameters into the template",
-					Required:    true,
+					Description: "List of key/value pairs to pass as parameters into the template. Exactly one of `elements` (which may be empty) or `elements_yaml` must be set.",
+					Optional:    true,
 					Elem: &schema.Schema{
 						Type: schema.TypeMap,
 						Elem: &schema.Schema{Type: schema.TypeString},
 					},
 				},
+				"elements_yaml": {
+					Type:        schema.TypeString,
+					Description: "YAML string containing the list of elements to pass as parameters into the template. Unlike `elements`, it can be templated, e.g. from the parameters of another generator within a matrix generator. Exactly one of `elements` or `elements_yaml` must be set.",
+					Optional:    true,
+				},
 				"template": {
 					Type:        schema.TypeList,
 					Description: "Generator template. Used to override the values of the spec-level template.",
@@ -425,6 +490,59 @@ func applicationSetMergeGeneratorSchemaV0(level int) *schema.Schema {
 	}
 }
 
+func applicationSetPluginGeneratorSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "[Plugin generators](https://argo-cd.readthedocs.io/en/stable/operator-manual/applicationset/Generators-Plugin/) generate parameters by calling an external plugin through its RPC API. Requires ArgoCD 2.8.0 or above.",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"config_map_ref": {
+					Type:        schema.TypeString,
+					Description: "Name of the ConfigMap holding the plugin configuration (i.e. its `baseUrl` and `token`).",
+					Required:    true,
+				},
+				"input": {
+					Type:        schema.TypeList,
+					Description: "Input sent to the plugin.",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"parameters": {
+								Type:             schema.TypeMap,
+								Description:      "Arbitrary parameters passed to the plugin. Values must be JSON encoded (e.g. using `jsonencode()`), so that parameters can be strings, numbers, booleans, lists or objects.",
+								Optional:         true,
+								Elem:             &schema.Schema{Type: schema.TypeString},
+								DiffSuppressFunc: suppressEquivalentJSON,
+							},
+						},
+					},
+				},
+				"requeue_after_seconds": {
+					Type:         schema.TypeString,
+					Description:  "How often to check for changes (in seconds). Default: 30min.",
+					Optional:     true,
+					ValidateFunc: validatePositiveInteger,
+				},
+				"template": {
+					Type:        schema.TypeList,
+					Description: "Generator template. Used to override the values of the spec-level template.",
+					Optional:    true,
+					MaxItems:    1,
+					Elem:        applicationSetTemplateResource(true),
+				},
+				"values": {
+					Type:        schema.TypeMap,
+					Description: "Arbitrary string key-value pairs which are passed directly as parameters to the template.",
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
 func applicationSetSCMProviderGeneratorSchemaV0() *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeList,
@@ -549,9 +667,10 @@ func applicationSetSCMProviderGeneratorSchemaV0() *schema.Schema {
 					},
 				},
 				"clone_protocol": {
-					Type:        schema.TypeString,
-					Description: "Which protocol to use for the SCM URL. Default is provider-specific but ssh if possible. Not all providers necessarily support all protocols.",
-					Optional:    true,
+					Type:         schema.TypeString,
+					Description:  "Which protocol to use for the SCM URL. Default is provider-specific but ssh if possible. Not all providers necessarily support all protocols. Valid values are `ssh` and `https`.",
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{"ssh", "https"}, false),
 				},
 				"filter": {
 					Type:        schema.TypeList,
@@ -685,11 +804,22 @@ func applicationSetSCMProviderGeneratorSchemaV0() *schema.Schema {
 								Description: "Gitlab group to scan. You can use either the project id (recommended) or the full namespaced path.",
 								Required:    true,
 							},
+							"include_shared_projects": {
+								Type:        schema.TypeBool,
+								Description: "Include projects shared with the group by other groups (true) or only those owned by the group (false). Defaults to `true`.",
+								Optional:    true,
+								Default:     true,
+							},
 							"include_subgroups": {
 								Type:        schema.TypeBool,
 								Description: "Recurse through subgroups (true) or scan only the base group (false). Defaults to `false`.",
 								Optional:    true,
 							},
+							"insecure": {
+								Type:        schema.TypeBool,
+								Description: "Skip TLS certificate verification when talking to the Gitlab API.",
+								Optional:    true,
+							},
 							"token_ref": {
 								Type:        schema.TypeList,
 								Description: "Authentication token reference.",
@@ -701,9 +831,10 @@ func applicationSetSCMProviderGeneratorSchemaV0() *schema.Schema {
 					},
 				},
 				"requeue_after_seconds": {
-					Type:        schema.TypeString,
-					Description: "How often to check for changes (in seconds). Default: 3min.",
-					Optional:    true,
+					Type:         schema.TypeString,
+					Description:  "How often to check for changes (in seconds). Default: 30min.",
+					Optional:     true,
+					ValidateFunc: validatePositiveInteger,
 				},
 				"template": {
 					Type:        schema.TypeList,
@@ -724,6 +855,70 @@ func applicationSetPullRequestGeneratorSchemaV0() *schema.Schema {
 		Optional:    true,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
+				"bitbucket": {
+					Type:        schema.TypeList,
+					Description: "Fetch pull requests from a repo hosted on Bitbucket Cloud.",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"api": {
+								Type:        schema.TypeString,
+								Description: "The Bitbucket REST API URL to talk to. If blank, uses https://api.bitbucket.org/2.0.",
+								Optional:    true,
+							},
+							"basic_auth": {
+								Type:        schema.TypeList,
+								Description: "Credentials for Basic auth (i.e. an app password).",
+								Optional:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"username": {
+											Type:        schema.TypeString,
+											Description: "Username for Basic auth.",
+											Optional:    true,
+										},
+										"password_ref": {
+											Type:        schema.TypeList,
+											Description: "App password reference.",
+											Optional:    true,
+											MaxItems:    1,
+											Elem:        secretRefResource(),
+										},
+									},
+								},
+							},
+							"bearer_token": {
+								Type:        schema.TypeList,
+								Description: "Credentials for Bearer token auth (i.e. a project, repository or workspace access token). Alternative to `basic_auth`.",
+								Optional:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"token_ref": {
+											Type:        schema.TypeList,
+											Description: "Access token reference.",
+											Required:    true,
+											MaxItems:    1,
+											Elem:        secretRefResource(),
+										},
+									},
+								},
+							},
+							"owner": {
+								Type:        schema.TypeString,
+								Description: "Workspace name where the repository is stored.",
+								Required:    true,
+							},
+							"repo": {
+								Type:        schema.TypeString,
+								Description: "Repository name to scan.",
+								Required:    true,
+							},
+						},
+					},
+				},
 				"bitbucket_server": {
 					Type:        schema.TypeList,
 					Description: "Fetch pull requests from a repo hosted on a Bitbucket Server.",
@@ -782,6 +977,11 @@ func applicationSetPullRequestGeneratorSchemaV0() *schema.Schema {
 								Description: "A regex which must match the branch name.",
 								Optional:    true,
 							},
+							"target_branch_match": {
+								Type:        schema.TypeString,
+								Description: "A regex which must match the target branch name.",
+								Optional:    true,
+							},
 						},
 					},
 				},
@@ -883,6 +1083,11 @@ func applicationSetPullRequestGeneratorSchemaV0() *schema.Schema {
 								Optional:    true,
 								Elem:        &schema.Schema{Type: schema.TypeString},
 							},
+							"insecure": {
+								Type:        schema.TypeBool,
+								Description: "Allow insecure tls, for self-signed certificates; default: false.",
+								Optional:    true,
+							},
 							"project": {
 								Type:        schema.TypeString,
 								Description: "GitLab project to scan.",
@@ -904,9 +1109,10 @@ func applicationSetPullRequestGeneratorSchemaV0() *schema.Schema {
 					},
 				},
 				"requeue_after_seconds": {
-					Type:        schema.TypeString,
-					Description: "How often to check for changes (in seconds). Default: 30min.",
-					Optional:    true,
+					Type:         schema.TypeString,
+					Description:  "How often to check for changes (in seconds). Default: 30min.",
+					Optional:     true,
+					ValidateFunc: validatePositiveInteger,
 				},
 				"template": {
 					Type:        schema.TypeList,
@@ -972,14 +1178,16 @@ func secretRefResource() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"key": {
-				Type:        schema.TypeString,
-				Description: "Key containing information in Kubernetes `Secret`.",
-				Required:    true,
+				Type:         schema.TypeString,
+				Description:  "Key containing information in Kubernetes `Secret`.",
+				Required:     true,
+				ValidateFunc: validateSecretKey,
 			},
 			"secret_name": {
-				Type:        schema.TypeString,
-				Description: "Name of Kubernetes `Secret`.",
-				Required:    true,
+				Type:         schema.TypeString,
+				Description:  "Name of Kubernetes `Secret`. The secret must exist in the namespace of the ApplicationSet controller.",
+				Required:     true,
+				ValidateFunc: validateMetadataName,
 			},
 		},
 	}
@@ -9,7 +9,7 @@ func clusterSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"name": {
 			Type:        schema.TypeString,
-			Description: "Name of the cluster. If omitted, will use the server address.",
+			Description: "Name of the cluster. If omitted, will use the server address, or `in-cluster` for the in-cluster entry (see `server`).",
 			Optional:    true,
 			DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
 				if k == "name" {
@@ -19,22 +19,29 @@ func clusterSchema() map[string]*schema.Schema {
 					if nameOk && serverOk && name == server && oldValue == server && newValue == "" {
 						return true
 					}
+					// Actual value is the default name of the in-cluster entry
+					if serverOk && isInCluster(server.(string)) && oldValue == inClusterName && newValue == "" {
+						return true
+					}
 				}
 				return false
 			},
 		},
 		"server": {
 			Type:        schema.TypeString,
-			Description: "Server is the API server URL of the Kubernetes cluster.",
+			Description: "Server is the API server URL of the Kubernetes cluster. Defaults to the server of `kubeconfig`, when set. Use `https://kubernetes.default.svc` to adopt and configure the in-cluster entry implicitly registered by ArgoCD for the cluster it is running in (e.g. rename it, restrict its namespaces or assign it a shard): it is connected to through the service account of ArgoCD unless `config` is set, and deleting the resource resets it to its defaults instead of removing it. Changing the server forces the creation of a new cluster, whereas any other attribute, including credentials, is updated in place.",
 			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
 			DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
 				return oldValue == strings.TrimRight(newValue, "/")
 			},
 		},
 		"shard": {
-			Type:        schema.TypeString,
-			Description: "Optional shard number. Calculated on the fly by the application controller if not specified.",
-			Optional:    true,
+			Type:         schema.TypeString,
+			Description:  "Optional shard number of the application controller the cluster is assigned to, when running a sharded application controller. Calculated on the fly by the application controller if not specified.",
+			Optional:     true,
+			ValidateFunc: validatePositiveInteger,
 		},
 		"namespaces": {
 			Type:        schema.TypeList,
@@ -44,17 +51,25 @@ func clusterSchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"cluster_resources": {
+			Type:        schema.TypeBool,
+			Description: "Whether cluster level resources are managed when `namespaces` is not empty.",
+			Optional:    true,
+		},
 		"config": {
-			Type:        schema.TypeList,
-			Description: "Cluster information for connecting to a cluster.",
-			Required:    true,
-			MinItems:    1,
-			MaxItems:    1,
+			Type:          schema.TypeList,
+			Description:   "Cluster information for connecting to a cluster. Exactly one of `config` or `kubeconfig` must be set, except for the in-cluster entry.",
+			Optional:      true,
+			MinItems:      1,
+			MaxItems:      1,
+			ConflictsWith: []string{"kubeconfig"},
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"aws_auth_config": {
-						Type:     schema.TypeList,
-						Optional: true,
+						Type:        schema.TypeList,
+						Description: "Configuration for authenticating to EKS clusters through the AWS IAM Authenticator (e.g. using IRSA), instead of a bearer token. Mirrors the `awsAuthConfig` field of the cluster secret.",
+						Optional:    true,
+						MaxItems:    1,
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								"cluster_name": {
@@ -67,6 +82,11 @@ func clusterSchema() map[string]*schema.Schema {
 									Optional:    true,
 									Description: "IAM role ARN. If set then AWS IAM Authenticator assume a role to perform cluster operations instead of the default AWS credential provider chain.",
 								},
+								"profile": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									Description: "AWS profile. If set then AWS IAM Authenticator uses the profile to perform cluster operations instead of the default AWS credential provider chain.",
+								},
 							},
 						},
 					},
@@ -80,18 +100,19 @@ func clusterSchema() map[string]*schema.Schema {
 						Type:        schema.TypeList,
 						Optional:    true,
 						MaxItems:    1,
-						Description: "Configuration for an exec provider used to call an external command to perform cluster authentication See: https://godoc.org/k8s.io/client-go/tools/clientcmd/api#ExecConfig.",
+						Description: "Configuration for an exec provider used to call an external command to perform cluster authentication (e.g. `argocd-k8s-auth` for GKE or EKS clusters). Mirrors the `execProviderConfig` field of the cluster secret. See: https://godoc.org/k8s.io/client-go/tools/clientcmd/api#ExecConfig.",
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								"api_version": {
-									Type:        schema.TypeString,
-									Optional:    true,
-									Description: "Preferred input version of the ExecInfo",
+									Type:         schema.TypeString,
+									Optional:     true,
+									Description:  "Preferred input version of the ExecInfo, either `client.authentication.k8s.io/v1` or `client.authentication.k8s.io/v1beta1`.",
+									ValidateFunc: validateExecProviderAPIVersion,
 								},
 								"args": {
 									Type:        schema.TypeList,
 									Optional:    true,
-									Description: "Arguments to pass to the command when executing it",
+									Description: "Arguments to pass to the command when executing it.",
 									Sensitive:   true,
 									Elem: &schema.Schema{
 										Type: schema.TypeString,
@@ -99,13 +120,13 @@ func clusterSchema() map[string]*schema.Schema {
 								},
 								"command": {
 									Type:        schema.TypeString,
-									Optional:    true,
-									Description: "Command to execute",
+									Required:    true,
+									Description: "Command to execute.",
 								},
 								"env": {
 									Type:        schema.TypeMap,
 									Optional:    true,
-									Description: "Env defines additional environment variables to expose to the process. Passed as a map of strings",
+									Description: "Env defines additional environment variables to expose to the process. Passed as a map of strings.",
 									Sensitive:   true,
 									Elem: &schema.Schema{
 										Type: schema.TypeString,
@@ -113,7 +134,7 @@ func clusterSchema() map[string]*schema.Schema {
 								},
 								"install_hint": {
 									Type:        schema.TypeString,
-									Description: "This text is shown to the user when the executable doesn't seem to be present",
+									Description: "This text is shown to the user when the executable doesn't seem to be present.",
 									Optional:    true,
 								},
 							},
@@ -169,6 +190,20 @@ func clusterSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"kubeconfig": {
+			Type:          schema.TypeString,
+			Description:   "Kubeconfig from which the server address, CA data and credentials (bearer token, basic authentication, client certificate or exec plugin) of the cluster are extracted, the same way `argocd cluster add` does, except that no service account is created on the cluster. Exactly one of `config` or `kubeconfig` must be set, except for the in-cluster entry. Files referenced by the kubeconfig are read upon apply. Auth provider plugins are not supported.",
+			Optional:      true,
+			Sensitive:     true,
+			ConflictsWith: []string{"config"},
+			ValidateFunc:  validateKubeconfig,
+		},
+		"context": {
+			Type:         schema.TypeString,
+			Description:  "Context of `kubeconfig` to extract the cluster configuration from. Defaults to the current context of `kubeconfig`.",
+			Optional:     true,
+			RequiredWith: []string{"kubeconfig"},
+		},
 		"info": {
 			Type:        schema.TypeList,
 			Description: "Information about cluster cache and state.",
@@ -209,9 +244,9 @@ func clusterSchema() map[string]*schema.Schema {
 		},
 		"metadata": {
 			Type:        schema.TypeList,
-			Description: "Standard cluster secret's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata",
+			Description: "Standard cluster secret's metadata. Labels can notably be matched by the cluster generator of application sets. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata",
 			Optional:    true,
-			MaxItems:    2,
+			MaxItems:    1,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"annotations": {
@@ -231,9 +266,31 @@ func clusterSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"force_delete": {
+			Type:        schema.TypeBool,
+			Description: "Whether to delete the cluster even though applications still target it. By default, the deletion fails with the list of these applications, as applications whose destination cluster does not exist anymore end up in an `Unknown` state.",
+			Optional:    true,
+		},
+		"invalidate_cache": {
+			Type:        schema.TypeBool,
+			Description: "Whether to invalidate the cluster cache of the application controller upon cluster update (or creation, when adopting an existing cluster through `upsert`), so that changes to the permissions of the credentials or to the APIs served by the cluster are taken into account immediately, instead of upon the next full resynchronization. **Note**: invalidating the cache triggers a full resynchronization, which may be costly for large clusters.",
+			Optional:    true,
+		},
+		"upsert": {
+			Type:        schema.TypeBool,
+			Description: "Whether to adopt and update the cluster when a cluster with the same server address is already registered (e.g. through `argocd cluster add` while bootstrapping), instead of failing. Only applies upon creation.",
+			Optional:    true,
+			Default:     false,
+		},
+		"wait_for_connection": {
+			Type:        schema.TypeBool,
+			Description: "Upon cluster creation or update, wait for the application controller to report a successful connection to the cluster, and fail with the connection error reported by ArgoCD otherwise. Wait timeouts are controlled by Terraform Create and Update resource timeouts (both default to 5 minutes). **Note**: clusters targeted by no application are not monitored by the application controller, in which case a warning is reported as soon as ArgoCD reports so, as only the connection check performed by ArgoCD upon registering the cluster then applies.",
+			Optional:    true,
+			Default:     false,
+		},
 		"project": {
 			Type:        schema.TypeString,
-			Description: "Reference between project and cluster that allow you automatically to be added as item inside Destinations project entity. More info: https://argo-cd.readthedocs.io/en/stable/user-guide/projects/#project-scoped-repositories-and-clusters.",
+			Description: "Name of the project the cluster is scoped to. Project-scoped clusters are automatically added to the destinations of the project. The project must exist beforehand. More info: https://argo-cd.readthedocs.io/en/stable/user-guide/projects/#project-scoped-repositories-and-clusters.",
 			Optional:    true,
 		},
 	}
@@ -0,0 +1,27 @@
+package argocd
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestClusterSchemaOnlyServerForcesNew(t *testing.T) {
+	t.Parallel()
+
+	var check func(prefix string, s map[string]*schema.Schema)
+
+	check = func(prefix string, s map[string]*schema.Schema) {
+		for k, v := range s {
+			if v.ForceNew != (prefix+k == "server") {
+				t.Errorf("%s%s: ForceNew = %t", prefix, k, v.ForceNew)
+			}
+
+			if r, ok := v.Elem.(*schema.Resource); ok {
+				check(prefix+k+".", r.Schema)
+			}
+		}
+	}
+
+	check("", clusterSchema())
+}
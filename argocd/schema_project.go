@@ -412,11 +412,12 @@ func projectSpecSchemaV1() *schema.Schema {
 
 func projectSpecSchemaV2() *schema.Schema {
 	return &schema.Schema{
-		Type:        schema.TypeList,
-		MinItems:    1,
-		MaxItems:    1,
-		Description: "ArgoCD AppProject spec.",
-		Required:    true,
+		Type:         schema.TypeList,
+		MinItems:     1,
+		MaxItems:     1,
+		Description:  "ArgoCD AppProject spec. Exactly one of `spec` or `manifest` must be set.",
+		Optional:     true,
+		ExactlyOneOf: []string{"spec", "manifest"},
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"cluster_resource_blacklist": {
@@ -427,14 +428,15 @@ func projectSpecSchemaV2() *schema.Schema {
 						Schema: map[string]*schema.Schema{
 							"group": {
 								Type:         schema.TypeString,
-								Description:  "The Kubernetes resource Group to match for.",
-								ValidateFunc: validateGroupName,
+								Description:  "The Kubernetes resource Group to match for. Leave empty for the core group. Supports wildcards, e.g. `*` matches all groups.",
+								ValidateFunc: validateResourceGroup,
 								Optional:     true,
 							},
 							"kind": {
-								Type:        schema.TypeString,
-								Description: "The Kubernetes resource Kind to match for.",
-								Optional:    true,
+								Type:         schema.TypeString,
+								Description:  "The Kubernetes resource Kind to match for. Supports wildcards, e.g. `*` matches all kinds.",
+								ValidateFunc: validateResourceKind,
+								Optional:     true,
 							},
 						},
 					},
@@ -447,14 +449,15 @@ func projectSpecSchemaV2() *schema.Schema {
 						Schema: map[string]*schema.Schema{
 							"group": {
 								Type:         schema.TypeString,
-								Description:  "The Kubernetes resource Group to match for.",
-								ValidateFunc: validateGroupName,
+								Description:  "The Kubernetes resource Group to match for. Leave empty for the core group. Supports wildcards, e.g. `*` matches all groups.",
+								ValidateFunc: validateResourceGroup,
 								Optional:     true,
 							},
 							"kind": {
-								Type:        schema.TypeString,
-								Description: "The Kubernetes resource Kind to match for.",
-								Optional:    true,
+								Type:         schema.TypeString,
+								Description:  "The Kubernetes resource Kind to match for. Supports wildcards, e.g. `*` matches all kinds.",
+								ValidateFunc: validateResourceKind,
+								Optional:     true,
 							},
 						},
 					},
@@ -490,19 +493,21 @@ func projectSpecSchemaV2() *schema.Schema {
 				},
 				"namespace_resource_blacklist": {
 					Type:        schema.TypeSet,
-					Description: "Blacklisted namespace level resources.",
+					Description: "Blacklisted namespace level resources, i.e. namespaced resources which applications of this project are not allowed to manage.",
 					Optional:    true,
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"group": {
-								Type:        schema.TypeString,
-								Description: "The Kubernetes resource Group to match for.",
-								Optional:    true,
+								Type:         schema.TypeString,
+								Description:  "The Kubernetes resource Group to match for. Leave empty for the core group. Supports wildcards, e.g. `*` matches all groups.",
+								ValidateFunc: validateResourceGroup,
+								Optional:     true,
 							},
 							"kind": {
-								Type:        schema.TypeString,
-								Description: "The Kubernetes resource Kind to match for.",
-								Optional:    true,
+								Type:         schema.TypeString,
+								Description:  "The Kubernetes resource Kind to match for. Supports wildcards, e.g. `*` matches all kinds.",
+								ValidateFunc: validateResourceKind,
+								Optional:     true,
 							},
 						},
 					},
@@ -514,14 +519,16 @@ func projectSpecSchemaV2() *schema.Schema {
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"group": {
-								Type:        schema.TypeString,
-								Description: "The Kubernetes resource Group to match for.",
-								Optional:    true,
+								Type:         schema.TypeString,
+								Description:  "The Kubernetes resource Group to match for. Leave empty for the core group. Supports wildcards, e.g. `*` matches all groups.",
+								ValidateFunc: validateResourceGroup,
+								Optional:     true,
 							},
 							"kind": {
-								Type:        schema.TypeString,
-								Description: "The Kubernetes resource Kind to match for.",
-								Optional:    true,
+								Type:         schema.TypeString,
+								Description:  "The Kubernetes resource Kind to match for. Supports wildcards, e.g. `*` matches all kinds.",
+								ValidateFunc: validateResourceKind,
+								Optional:     true,
 							},
 						},
 					},
@@ -539,25 +546,28 @@ func projectSpecSchemaV2() *schema.Schema {
 								Optional:    true,
 							},
 							"ignore": {
-								Type:     schema.TypeSet,
-								Optional: true,
+								Type:        schema.TypeSet,
+								Description: "List of resources that are not considered as orphaned, e.g. resources which are known to be created out-of-band.",
+								Optional:    true,
 								Elem: &schema.Resource{
 									Schema: map[string]*schema.Schema{
 										"group": {
 											Type:         schema.TypeString,
-											Description:  "The Kubernetes resource Group to match for.",
+											Description:  "The Kubernetes resource Group to match for. Supports glob patterns (e.g. `*.example.com`). Leave empty to match the core group.",
 											ValidateFunc: validateGroupName,
 											Optional:     true,
 										},
 										"kind": {
-											Type:        schema.TypeString,
-											Description: "The Kubernetes resource Kind to match for.",
-											Optional:    true,
+											Type:         schema.TypeString,
+											Description:  "The Kubernetes resource Kind to match for. Supports glob patterns. Leave empty to match any kind.",
+											ValidateFunc: validateGlob,
+											Optional:     true,
 										},
 										"name": {
-											Type:        schema.TypeString,
-											Description: "The Kubernetes resource name to match for.",
-											Optional:    true,
+											Type:         schema.TypeString,
+											Description:  "The Kubernetes resource name to match for. Supports glob patterns (e.g. `ignored-*`). Leave empty to match any name.",
+											ValidateFunc: validateGlob,
+											Optional:     true,
 										},
 									},
 								},
@@ -605,18 +615,22 @@ func projectSpecSchemaV2() *schema.Schema {
 				},
 				"source_namespaces": {
 					Type:        schema.TypeSet,
-					Description: "List of namespaces that application resources are allowed to be created in.",
+					Description: "List of namespaces that application resources are allowed to be created in. Entries may be glob patterns (e.g. `team-*`).",
 					Set:         schema.HashString,
 					Optional:    true,
 					Elem: &schema.Schema{
-						Type: schema.TypeString,
+						Type:         schema.TypeString,
+						ValidateFunc: validateGlob,
 					},
 				},
 				"signature_keys": {
 					Type:        schema.TypeList,
-					Description: "List of PGP key IDs that commits in Git must be signed with in order to be allowed for sync.",
+					Description: "List of PGP key IDs that commits in Git must be signed with in order to be allowed for sync. Keys must be known to ArgoCD, e.g. by referencing the `id` of an `argocd_gpg_key` resource.",
 					Optional:    true,
-					Elem:        &schema.Schema{Type: schema.TypeString},
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateGPGKeyID,
+					},
 				},
 				"sync_window": {
 					Type:        schema.TypeList,
@@ -660,17 +674,19 @@ func projectSpecSchemaV2() *schema.Schema {
 								Elem:        &schema.Schema{Type: schema.TypeString},
 							},
 							"schedule": {
-								Type:         schema.TypeString,
-								Description:  "Time the window will begin, specified in cron format.",
-								ValidateFunc: validateSyncWindowSchedule,
-								Optional:     true,
+								Type:             schema.TypeString,
+								Description:      "Time the window will begin, specified in cron format (e.g. `0 22 * * *`) and evaluated in `timezone`.",
+								ValidateFunc:     validateSyncWindowSchedule,
+								DiffSuppressFunc: suppressEquivalentSyncWindowSchedules,
+								Optional:         true,
 							},
 							"timezone": {
-								Type:         schema.TypeString,
-								Description:  "Timezone that the schedule will be evaluated in.",
-								ValidateFunc: validateSyncWindowTimezone,
-								Optional:     true,
-								Default:      "UTC",
+								Type:             schema.TypeString,
+								Description:      "Timezone that the schedule will be evaluated in, from the IANA time zone database (e.g. `Europe/Paris`).",
+								ValidateFunc:     validateSyncWindowTimezone,
+								DiffSuppressFunc: suppressEquivalentSyncWindowTimezones,
+								Optional:         true,
+								Default:          "UTC",
 							},
 						},
 					},
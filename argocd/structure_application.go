@@ -3,21 +3,110 @@ package argocd
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
+	applicationClient "github.com/dcoppa/argo-cd/v2/pkg/apiclient/application"
 	application "github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // Expand
 
 func expandApplication(d *schema.ResourceData) (metadata meta.ObjectMeta, spec application.ApplicationSpec, err error) {
 	metadata = expandMetadata(d)
+
+	if d.Get("paused").(bool) {
+		if metadata.Annotations == nil {
+			metadata.Annotations = make(map[string]string)
+		}
+
+		metadata.Annotations[applicationSkipReconcileAnnotation] = "true"
+	}
+
 	spec, err = expandApplicationSpec(d.Get("spec.0").(map[string]interface{}))
 
 	return
 }
 
+const (
+	applicationResourcesFinalizer           = "resources-finalizer.argocd.argoproj.io"
+	applicationResourcesBackgroundFinalizer = "resources-finalizer.argocd.argoproj.io/background"
+	applicationSkipReconcileAnnotation      = "argocd.argoproj.io/skip-reconcile"
+)
+
+// expandApplicationDeletionFinalizer returns the given finalizers, with the
+// ArgoCD resources finalizers replaced according to the `deletion_finalizer`
+// mode.
+func expandApplicationDeletionFinalizer(mode string, finalizers []string) []string {
+	if mode == "" {
+		// Not configured (nor known yet), leave finalizers untouched
+		return finalizers
+	}
+
+	var result []string
+
+	for _, f := range finalizers {
+		if f != applicationResourcesFinalizer && f != applicationResourcesBackgroundFinalizer {
+			result = append(result, f)
+		}
+	}
+
+	switch mode {
+	case "foreground":
+		result = append(result, applicationResourcesFinalizer)
+	case "background":
+		result = append(result, applicationResourcesBackgroundFinalizer)
+	}
+
+	return result
+}
+
+func expandApplicationSyncRequest(name, namespace string, in interface{}) *applicationClient.ApplicationSyncRequest {
+	req := &applicationClient.ApplicationSyncRequest{
+		Name:         &name,
+		AppNamespace: &namespace,
+	}
+
+	s, ok := in.(map[string]interface{})
+	if !ok {
+		return req
+	}
+
+	if v, ok := s["revision"].(string); ok && v != "" {
+		req.Revision = &v
+	}
+
+	if v, ok := s["prune"].(bool); ok {
+		req.Prune = &v
+	}
+
+	force, _ := s["force"].(bool)
+
+	switch s["strategy"] {
+	case "apply":
+		req.Strategy = &application.SyncStrategy{
+			Apply: &application.SyncStrategyApply{Force: force},
+		}
+	default:
+		req.Strategy = &application.SyncStrategy{
+			Hook: &application.SyncStrategyHook{
+				SyncStrategyApply: application.SyncStrategyApply{Force: force},
+			},
+		}
+	}
+
+	if v, ok := s["sync_options"].([]interface{}); ok && len(v) > 0 {
+		req.SyncOptions = &applicationClient.SyncOptions{
+			Items: expandStringList(v),
+		}
+	}
+
+	return req
+}
+
 func expandApplicationSpec(s map[string]interface{}) (spec application.ApplicationSpec, err error) {
 	if v, ok := s["project"]; ok {
 		spec.Project = v.(string)
@@ -127,6 +216,37 @@ func expandApplicationSourcePlugin(in []interface{}) *application.ApplicationSou
 		}
 	}
 
+	if parameters, ok := a["parameter"]; ok {
+		for _, _p := range parameters.([]interface{}) {
+			p, ok := _p.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			parameter := application.ApplicationSourcePluginParameter{
+				Name: p["name"].(string),
+			}
+
+			if v, ok := p["string"].(string); ok && v != "" {
+				parameter.String_ = &v
+			}
+
+			if v, ok := p["map"].(map[string]interface{}); ok && len(v) > 0 {
+				parameter.OptionalMap = &application.OptionalMap{
+					Map: expandStringMap(v),
+				}
+			}
+
+			if v, ok := p["array"].([]interface{}); ok && len(v) > 0 {
+				parameter.OptionalArray = &application.OptionalArray{
+					Array: expandStringList(v),
+				}
+			}
+
+			result.Parameters = append(result.Parameters, parameter)
+		}
+	}
+
 	return result
 }
 
@@ -234,6 +354,84 @@ func expandApplicationSourceKustomize(in []interface{}) *application.Application
 		}
 	}
 
+	if v, ok := a["common_labels_force"]; ok {
+		result.ForceCommonLabels = v.(bool)
+	}
+
+	if v, ok := a["common_annotations_force"]; ok {
+		result.ForceCommonAnnotations = v.(bool)
+	}
+
+	if v, ok := a["label_without_selector"]; ok {
+		result.LabelWithoutSelector = v.(bool)
+	}
+
+	if v, ok := a["namespace"]; ok {
+		result.Namespace = v.(string)
+	}
+
+	if v, ok := a["components"]; ok {
+		for _, c := range v.([]interface{}) {
+			result.Components = append(result.Components, c.(string))
+		}
+	}
+
+	if v, ok := a["patches"]; ok {
+		for _, _p := range v.([]interface{}) {
+			p, ok := _p.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			patch := application.KustomizePatch{
+				Patch: p["patch"].(string),
+				Path:  p["path"].(string),
+			}
+
+			if opts, ok := p["options"].(map[string]interface{}); ok && len(opts) > 0 {
+				patch.Options = make(map[string]bool, len(opts))
+
+				for k, o := range opts {
+					patch.Options[k] = o.(bool)
+				}
+			}
+
+			if ts, ok := p["target"].([]interface{}); ok && len(ts) > 0 && ts[0] != nil {
+				t := ts[0].(map[string]interface{})
+
+				patch.Target = &application.KustomizeSelector{
+					KustomizeResId: application.KustomizeResId{
+						KustomizeGvk: application.KustomizeGvk{
+							Group:   t["group"].(string),
+							Version: t["version"].(string),
+							Kind:    t["kind"].(string),
+						},
+						Name:      t["name"].(string),
+						Namespace: t["namespace"].(string),
+					},
+					LabelSelector:      t["label_selector"].(string),
+					AnnotationSelector: t["annotation_selector"].(string),
+				}
+			}
+
+			result.Patches = append(result.Patches, patch)
+		}
+	}
+
+	if v, ok := a["replicas"]; ok {
+		for _, _r := range v.([]interface{}) {
+			r, ok := _r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			result.Replicas = append(result.Replicas, application.KustomizeReplica{
+				Name:  r["name"].(string),
+				Count: intstr.Parse(r["count"].(string)),
+			})
+		}
+	}
+
 	return result
 }
 
@@ -293,6 +491,18 @@ func expandApplicationSourceHelm(in []interface{}) *application.ApplicationSourc
 		for _, _p := range fileParameters.(*schema.Set).List() {
 			p := _p.(map[string]interface{})
 
+			if v, ok := p["content"]; ok && v.(string) != "" {
+				// Inline file contents are passed as string parameters, which
+				// is equivalent to `helm template --set-file`.
+				result.Parameters = append(result.Parameters, application.HelmParameter{
+					Name:        p["name"].(string),
+					Value:       escapeHelmParameterValue(v.(string)),
+					ForceString: true,
+				})
+
+				continue
+			}
+
 			parameter := application.HelmFileParameter{}
 
 			if v, ok := p["name"]; ok {
@@ -314,6 +524,18 @@ func expandApplicationSourceHelm(in []interface{}) *application.ApplicationSourc
 	return result
 }
 
+// applicationSyncOptionFields maps the fields of the typed `options` block to
+// their respective sync option.
+var applicationSyncOptionFields = map[string]string{
+	"apply_out_of_sync_only":     "ApplyOutOfSyncOnly",
+	"create_namespace":           "CreateNamespace",
+	"fail_on_shared_resource":    "FailOnSharedResource",
+	"prune_last":                 "PruneLast",
+	"replace":                    "Replace",
+	"respect_ignore_differences": "RespectIgnoreDifferences",
+	"server_side_apply":          "ServerSideApply",
+}
+
 func expandApplicationSyncPolicy(sp interface{}) (*application.SyncPolicy, error) {
 	var syncPolicy = &application.SyncPolicy{}
 
@@ -356,6 +578,25 @@ func expandApplicationSyncPolicy(sp interface{}) (*application.SyncPolicy, error
 		syncPolicy.SyncOptions = syncOptions
 	}
 
+	if _opts, ok := p["options"].([]interface{}); ok && len(_opts) > 0 && _opts[0] != nil {
+		opts := _opts[0].(map[string]interface{})
+
+		// Sort fields so that options are always appended in the same order
+		fields := make([]string, 0, len(applicationSyncOptionFields))
+		for field := range applicationSyncOptionFields {
+			fields = append(fields, field)
+		}
+
+		sort.Strings(fields)
+
+		for _, field := range fields {
+			option := applicationSyncOptionFields[field]
+			if v, ok := opts[field].(bool); ok && v && !syncPolicy.SyncOptions.HasOption(option+"=true") {
+				syncPolicy.SyncOptions = append(syncPolicy.SyncOptions, option+"=true")
+			}
+		}
+	}
+
 	if _retry, ok := p["retry"].([]interface{}); ok && len(_retry) > 0 {
 		var retry = &application.RetryStrategy{}
 
@@ -451,6 +692,13 @@ func expandApplicationIgnoreDifferences(ids []interface{}) (result []application
 			}
 		}
 
+		if v, ok := id["managed_fields_managers"]; ok {
+			mfms := v.(*schema.Set).List()
+			for _, mfm := range mfms {
+				elem.ManagedFieldsManagers = append(elem.ManagedFieldsManagers, mfm.(string))
+			}
+		}
+
 		result = append(result, elem)
 	}
 
@@ -526,6 +774,14 @@ func expandSyncWindows(sws []interface{}) (result []*application.SyncWindow) {
 // Flatten
 
 func flattenApplication(app *application.Application, d *schema.ResourceData) error {
+	paused := app.Annotations[applicationSkipReconcileAnnotation] == "true"
+
+	// The skip-reconcile annotation is managed through `paused`, unless it is
+	// explicitly set in the metadata annotations
+	if !isKeyInMap(applicationSkipReconcileAnnotation, d.Get("metadata.0.annotations").(map[string]interface{})) {
+		delete(app.Annotations, applicationSkipReconcileAnnotation)
+	}
+
 	metadata := flattenMetadata(app.ObjectMeta, d)
 	if err := d.Set("metadata", metadata); err != nil {
 		e, _ := json.MarshalIndent(metadata, "", "\t")
@@ -533,6 +789,10 @@ func flattenApplication(app *application.Application, d *schema.ResourceData) er
 	}
 
 	spec := flattenApplicationSpec(app.Spec)
+	flattenApplicationHelmFileParameterContents(spec, d)
+	flattenApplicationSyncOptions(spec, d)
+	normalizeApplicationSpec(spec, d, "spec.0")
+
 	if err := d.Set("spec", spec); err != nil {
 		e, _ := json.MarshalIndent(spec, "", "\t")
 		return fmt.Errorf("error persisting spec: %s\n%s", err, e)
@@ -544,9 +804,280 @@ func flattenApplication(app *application.Application, d *schema.ResourceData) er
 		return fmt.Errorf("error persisting status: %s\n%s", err, e)
 	}
 
+	computed := map[string]interface{}{
+		"deletion_finalizer": flattenApplicationDeletionFinalizer(app.Finalizers),
+		"paused":             paused,
+		"health_status":      string(app.Status.Health.Status),
+		"sync_status":        string(app.Status.Sync.Status),
+		"sync_revision":      app.Status.Sync.Revision,
+		"images":             app.Status.Summary.Images,
+		"external_urls":      app.Status.Summary.ExternalURLs,
+	}
+
+	if os := app.Status.OperationState; os != nil {
+		computed["last_operation_phase"] = string(os.Phase)
+		computed["last_operation_message"] = os.Message
+
+		if os.SyncResult != nil {
+			computed["last_synced_revision"] = os.SyncResult.Revision
+		}
+	}
+
+	for k, v := range computed {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error persisting %s: %s", k, err)
+		}
+	}
+
 	return nil
 }
 
+// flattenApplicationHelmFileParameterContents moves the Helm parameters that
+// originate from inline `file_parameter` contents back to `file_parameter`.
+func flattenApplicationHelmFileParameterContents(spec []map[string]interface{}, d *schema.ResourceData) {
+	sources, ok := spec[0]["source"].([]map[string]interface{})
+	if !ok {
+		return
+	}
+
+	for i, source := range sources {
+		fps, ok := d.Get(fmt.Sprintf("spec.0.source.%d.helm.0.file_parameter", i)).(*schema.Set)
+		if !ok || fps.Len() == 0 {
+			continue
+		}
+
+		helm, ok := source["helm"].([]map[string]interface{})
+		if !ok || len(helm) == 0 {
+			continue
+		}
+
+		parameters, _ := helm[0]["parameter"].([]map[string]interface{})
+		fileParameters, _ := helm[0]["file_parameter"].([]map[string]interface{})
+
+		for _, _fp := range fps.List() {
+			fp := _fp.(map[string]interface{})
+			if fp["content"].(string) == "" {
+				continue
+			}
+
+			for j, p := range parameters {
+				if p["name"] == fp["name"] && p["force_string"] == true {
+					fileParameters = append(fileParameters, map[string]interface{}{
+						"name":    p["name"],
+						"content": unescapeHelmParameterValue(p["value"].(string)),
+					})
+					parameters = append(parameters[:j], parameters[j+1:]...)
+
+					break
+				}
+			}
+		}
+
+		helm[0]["parameter"] = parameters
+		helm[0]["file_parameter"] = fileParameters
+	}
+}
+
+// helmParameterValueEscaper escapes the characters Helm interprets in the
+// values of `--set-string` parameters. Commas are escaped regardless of ArgoCD
+// doing so, as it passes values enclosed in braces (e.g. JSON documents) as-is.
+var helmParameterValueEscaper = strings.NewReplacer(`\`, `\\`, `,`, `\,`)
+
+// escapeHelmParameterValue escapes value so that Helm parses it verbatim.
+func escapeHelmParameterValue(value string) string {
+	return helmParameterValueEscaper.Replace(value)
+}
+
+// unescapeHelmParameterValue reverses escapeHelmParameterValue.
+func unescapeHelmParameterValue(value string) string {
+	var b strings.Builder
+
+	escaped := false
+
+	for _, r := range value {
+		if r == '\\' && !escaped {
+			escaped = true
+			continue
+		}
+
+		escaped = false
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// flattenApplicationSyncOptions moves the sync options that can be expressed
+// through the typed `options` block back to it, if the block is in use.
+func flattenApplicationSyncOptions(spec []map[string]interface{}, d *schema.ResourceData) {
+	if opts, ok := d.Get("spec.0.sync_policy.0.options").([]interface{}); !ok || len(opts) == 0 {
+		return
+	}
+
+	syncPolicy, ok := spec[0]["sync_policy"].([]map[string]interface{})
+	if !ok || len(syncPolicy) == 0 {
+		return
+	}
+
+	syncOptions, _ := syncPolicy[0]["sync_options"].([]string)
+
+	configured := make(map[string]bool)
+	for _, so := range d.Get("spec.0.sync_policy.0.sync_options").([]interface{}) {
+		configured[so.(string)] = true
+	}
+
+	var remaining []string
+
+	options := make(map[string]interface{})
+
+	for _, so := range syncOptions {
+		moved := false
+
+		for field, option := range applicationSyncOptionFields {
+			if so == option+"=true" && !configured[so] {
+				options[field] = true
+				moved = true
+
+				break
+			}
+		}
+
+		if !moved {
+			remaining = append(remaining, so)
+		}
+	}
+
+	syncPolicy[0]["sync_options"] = remaining
+	syncPolicy[0]["options"] = []map[string]interface{}{options}
+}
+
+// normalizeApplicationSpec reconciles a flattened application spec with the
+// configuration found under prefix (e.g. `spec.0`), so that values defaulted
+// or rewritten by ArgoCD do not produce perpetual diffs.
+func normalizeApplicationSpec(spec []map[string]interface{}, d *schema.ResourceData, prefix string) {
+	if len(spec) == 0 {
+		return
+	}
+
+	if p, ok := spec[0]["project"].(string); ok && p == "" {
+		spec[0]["project"] = "default"
+	}
+
+	if sources, ok := spec[0]["source"].([]map[string]interface{}); ok {
+		for i, source := range sources {
+			normalizeApplicationSource(source, d, fmt.Sprintf("%s.source.%d", prefix, i))
+		}
+	}
+
+	syncPolicy, ok := spec[0]["sync_policy"].([]map[string]interface{})
+	if !ok || len(syncPolicy) == 0 {
+		return
+	}
+
+	if syncOptions, ok := syncPolicy[0]["sync_options"].([]string); ok {
+		configured, _ := d.Get(prefix + ".sync_policy.0.sync_options").([]interface{})
+		syncPolicy[0]["sync_options"] = normalizeStringListOrder(configured, syncOptions)
+	}
+
+	// Backoff is a set, hence equivalent durations (e.g. `5m` and `300`) must
+	// be kept as configured for the set hash not to change
+	retry, ok := syncPolicy[0]["retry"].([]map[string]interface{})
+	if !ok || len(retry) == 0 {
+		return
+	}
+
+	backoff, ok := retry[0]["backoff"].([]map[string]interface{})
+	if !ok || len(backoff) == 0 {
+		return
+	}
+
+	configured, ok := d.Get(prefix + ".sync_policy.0.retry.0.backoff").(*schema.Set)
+	if !ok || configured.Len() == 0 {
+		return
+	}
+
+	c := configured.List()[0].(map[string]interface{})
+
+	for _, k := range []string{"duration", "max_duration"} {
+		live, _ := backoff[0][k].(string)
+		if v, ok := c[k].(string); ok && live != v && equivalentBackoffDurations(v, live) {
+			backoff[0][k] = v
+		}
+	}
+}
+
+// normalizeApplicationSource reconciles a flattened application source with
+// the configuration found under prefix (e.g. `spec.0.source.0`).
+func normalizeApplicationSource(source map[string]interface{}, d *schema.ResourceData, prefix string) {
+	// ArgoCD drops tool specific blocks that do not have any field set
+	for _, block := range []string{"helm", "kustomize"} {
+		if b, ok := source[block].([]map[string]interface{}); ok && len(b) > 0 {
+			continue
+		}
+
+		if n, ok := d.Get(prefix + "." + block + ".#").(int); ok && n == 1 {
+			source[block] = []map[string]interface{}{{}}
+		}
+	}
+
+	helm, ok := source["helm"].([]map[string]interface{})
+	if !ok || len(helm) == 0 {
+		return
+	}
+
+	// Helm values are equivalent regardless of trailing newlines, which
+	// heredocs and `yamlencode` do not agree on
+	if values, ok := helm[0]["values"].(string); ok {
+		configured, _ := d.Get(prefix + ".helm.0.values").(string)
+		if configured != values && strings.TrimRight(configured, "\n") == strings.TrimRight(values, "\n") {
+			helm[0]["values"] = configured
+		}
+	}
+}
+
+// normalizeStringListOrder returns the configured list when it holds the
+// same elements as the actual one, regardless of their order, and the actual
+// list otherwise.
+func normalizeStringListOrder(configured []interface{}, actual []string) []string {
+	if len(configured) != len(actual) {
+		return actual
+	}
+
+	counts := make(map[string]int, len(actual))
+	for _, a := range actual {
+		counts[a]++
+	}
+
+	result := make([]string, 0, len(configured))
+
+	for _, c := range configured {
+		s, ok := c.(string)
+		if !ok || counts[s] == 0 {
+			return actual
+		}
+
+		counts[s]--
+
+		result = append(result, s)
+	}
+
+	return result
+}
+
+func flattenApplicationDeletionFinalizer(finalizers []string) string {
+	for _, f := range finalizers {
+		switch f {
+		case applicationResourcesFinalizer:
+			return "foreground"
+		case applicationResourcesBackgroundFinalizer:
+			return "background"
+		}
+	}
+
+	return "none"
+}
+
 func flattenApplicationSpec(s application.ApplicationSpec) []map[string]interface{} {
 	spec := map[string]interface{}{
 		"destination":       flattenApplicationDestinations([]application.ApplicationDestination{s.Destination}),
@@ -645,12 +1176,13 @@ func flattenApplicationInfo(infos []application.Info) (result []map[string]strin
 func flattenApplicationIgnoreDifferences(ids []application.ResourceIgnoreDifferences) (result []map[string]interface{}) {
 	for _, id := range ids {
 		result = append(result, map[string]interface{}{
-			"group":               id.Group,
-			"kind":                id.Kind,
-			"name":                id.Name,
-			"namespace":           id.Namespace,
-			"json_pointers":       id.JSONPointers,
-			"jq_path_expressions": id.JQPathExpressions,
+			"group":                   id.Group,
+			"kind":                    id.Kind,
+			"name":                    id.Name,
+			"namespace":               id.Namespace,
+			"json_pointers":           id.JSONPointers,
+			"jq_path_expressions":     id.JQPathExpressions,
+			"managed_fields_managers": id.ManagedFieldsManagers,
 		})
 	}
 
@@ -686,9 +1218,31 @@ func flattenApplicationSourcePlugin(as []*application.ApplicationSourcePlugin) (
 				})
 			}
 
+			var parameters []map[string]interface{}
+			for _, p := range a.Parameters {
+				parameter := map[string]interface{}{
+					"name": p.Name,
+				}
+
+				if p.String_ != nil {
+					parameter["string"] = *p.String_
+				}
+
+				if p.OptionalMap != nil {
+					parameter["map"] = p.OptionalMap.Map
+				}
+
+				if p.OptionalArray != nil {
+					parameter["array"] = p.OptionalArray.Array
+				}
+
+				parameters = append(parameters, parameter)
+			}
+
 			result = append(result, map[string]interface{}{
-				"name": a.Name,
-				"env":  env,
+				"name":      a.Name,
+				"env":       env,
+				"parameter": parameters,
 			})
 		}
 	}
@@ -745,13 +1299,53 @@ func flattenApplicationSourceKustomize(as []*application.ApplicationSourceKustom
 				images = append(images, string(i))
 			}
 
+			var patches []map[string]interface{}
+			for _, p := range a.Patches {
+				patch := map[string]interface{}{
+					"patch":   p.Patch,
+					"path":    p.Path,
+					"options": p.Options,
+				}
+
+				if p.Target != nil {
+					patch["target"] = []map[string]interface{}{
+						{
+							"group":               p.Target.Group,
+							"version":             p.Target.Version,
+							"kind":                p.Target.Kind,
+							"name":                p.Target.Name,
+							"namespace":           p.Target.Namespace,
+							"label_selector":      p.Target.LabelSelector,
+							"annotation_selector": p.Target.AnnotationSelector,
+						},
+					}
+				}
+
+				patches = append(patches, patch)
+			}
+
+			var replicas []map[string]interface{}
+			for _, r := range a.Replicas {
+				replicas = append(replicas, map[string]interface{}{
+					"name":  r.Name,
+					"count": r.Count.String(),
+				})
+			}
+
 			result = append(result, map[string]interface{}{
-				"common_annotations": a.CommonAnnotations,
-				"common_labels":      a.CommonLabels,
-				"images":             images,
-				"name_prefix":        a.NamePrefix,
-				"name_suffix":        a.NameSuffix,
-				"version":            a.Version,
+				"common_annotations":       a.CommonAnnotations,
+				"common_annotations_force": a.ForceCommonAnnotations,
+				"common_labels":            a.CommonLabels,
+				"common_labels_force":      a.ForceCommonLabels,
+				"components":               a.Components,
+				"images":                   images,
+				"label_without_selector":   a.LabelWithoutSelector,
+				"name_prefix":              a.NamePrefix,
+				"name_suffix":              a.NameSuffix,
+				"namespace":                a.Namespace,
+				"patches":                  patches,
+				"replicas":                 replicas,
+				"version":                  a.Version,
 			})
 		}
 	}
@@ -908,5 +1502,10 @@ func flattenApplicationOperationState(os application.OperationState) []map[strin
 		s["finished_at"] = os.FinishedAt.String()
 	}
 
+	if os.SyncResult != nil {
+		s["revision"] = os.SyncResult.Revision
+		s["revisions"] = os.SyncResult.Revisions
+	}
+
 	return []map[string]interface{}{s}
 }
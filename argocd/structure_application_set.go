@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 
 	application "github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,14 +13,14 @@ import (
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func expandApplicationSet(d *schema.ResourceData, featureMultipleApplicationSourcesSupported bool, featureApplicationSetIgnoreApplicationDifferences bool) (metadata meta.ObjectMeta, spec application.ApplicationSetSpec, err error) {
+func expandApplicationSet(d *schema.ResourceData, featureMultipleApplicationSourcesSupported bool) (metadata meta.ObjectMeta, spec application.ApplicationSetSpec, err error) {
 	metadata = expandMetadata(d)
-	spec, err = expandApplicationSetSpec(d, featureMultipleApplicationSourcesSupported, featureApplicationSetIgnoreApplicationDifferences)
+	spec, err = expandApplicationSetSpec(d, featureMultipleApplicationSourcesSupported)
 
 	return
 }
 
-func expandApplicationSetSpec(d *schema.ResourceData, featureMultipleApplicationSourcesSupported bool, featureApplicationSetIgnoreApplicationDifferences bool) (spec application.ApplicationSetSpec, err error) {
+func expandApplicationSetSpec(d *schema.ResourceData, featureMultipleApplicationSourcesSupported bool) (spec application.ApplicationSetSpec, err error) {
 	s := d.Get("spec.0").(map[string]interface{})
 
 	if v, ok := s["generator"].([]interface{}); ok && len(v) > 0 {
@@ -31,6 +32,10 @@ func expandApplicationSetSpec(d *schema.ResourceData, featureMultipleApplication
 
 	spec.GoTemplate = s["go_template"].(bool)
 
+	if v, ok := s["go_template_options"].(*schema.Set); ok && v.Len() > 0 {
+		spec.GoTemplateOptions = expandStringList(v.List())
+	}
+
 	if v, ok := s["strategy"].([]interface{}); ok && len(v) > 0 {
 		spec.Strategy, err = expandApplicationSetStrategy(v[0].(map[string]interface{}))
 		if err != nil {
@@ -42,8 +47,12 @@ func expandApplicationSetSpec(d *schema.ResourceData, featureMultipleApplication
 		spec.SyncPolicy = expandApplicationSetSyncPolicy(v[0].(map[string]interface{}))
 	}
 
+	if v, ok := s["preserved_fields"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		spec.PreservedFields = expandApplicationSetPreservedFields(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := s["ignore_application_differences"].([]interface{}); ok && len(v) > 0 {
-		spec.IgnoreApplicationDifferences = expandApplicationSetIgnoreDifferences(v, featureApplicationSetIgnoreApplicationDifferences)
+		spec.IgnoreApplicationDifferences = expandApplicationSetIgnoreDifferences(v)
 	}
 
 	if v, ok := s["template"].([]interface{}); ok && len(v) > 0 {
@@ -53,6 +62,10 @@ func expandApplicationSetSpec(d *schema.ResourceData, featureMultipleApplication
 		}
 	}
 
+	if v, ok := s["template_patch"].(string); ok && len(v) > 0 {
+		spec.TemplatePatch = &v
+	}
+
 	return spec, nil
 }
 
@@ -82,6 +95,8 @@ func expandApplicationSetGenerators(g []interface{}, featureMultipleApplicationS
 			g, err = expandApplicationSetSCMProviderGenerator(asg[0], featureMultipleApplicationSourcesSupported)
 		} else if asg, ok = v["pull_request"].([]interface{}); ok && len(asg) > 0 {
 			g, err = expandApplicationSetPullRequestGeneratorGenerator(asg[0], featureMultipleApplicationSourcesSupported)
+		} else if asg, ok = v["plugin"].([]interface{}); ok && len(asg) > 0 {
+			g, err = expandApplicationSetPluginGenerator(asg[0], featureMultipleApplicationSourcesSupported)
 		}
 
 		if err != nil {
@@ -213,17 +228,33 @@ func expandApplicationSetGitGenerator(gg interface{}, featureMultipleApplication
 		asg.Git.Template = temp
 	}
 
+	if v, ok := g["requeue_after_seconds"].(string); ok && len(v) > 0 {
+		ras, err := convertStringToInt64Pointer(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert requeue_after_seconds to *int64: %w", err)
+		}
+
+		asg.Git.RequeueAfterSeconds = ras
+	}
+
+	if v, ok := g["values"]; ok {
+		asg.Git.Values = expandStringMap(v.(map[string]interface{}))
+	}
+
 	return asg, nil
 }
 
 func expandApplicationSetListGenerator(lg interface{}, featureMultipleApplicationSourcesSupported bool) (*application.ApplicationSetGenerator, error) {
 	asg := &application.ApplicationSetGenerator{
-		List: &application.ListGenerator{},
+		List: &application.ListGenerator{
+			Elements: []apiextensionsv1.JSON{},
+		},
 	}
 
 	l := lg.(map[string]interface{})
 
 	e := l["elements"].([]interface{})
+	asg.List.ElementsYaml = l["elements_yaml"].(string)
 
 	for _, v := range e {
 		data, err := json.Marshal(v)
@@ -266,6 +297,7 @@ func expandApplicationSetMatrixGenerator(mg interface{}, featureMultipleApplicat
 			Clusters:                g.Clusters,
 			Git:                     g.Git,
 			List:                    g.List,
+			Plugin:                  g.Plugin,
 			PullRequest:             g.PullRequest,
 			SCMProvider:             g.SCMProvider,
 		}
@@ -335,6 +367,7 @@ func expandApplicationSetMergeGenerator(mg interface{}, featureMultipleApplicati
 			Clusters:                g.Clusters,
 			Git:                     g.Git,
 			List:                    g.List,
+			Plugin:                  g.Plugin,
 			PullRequest:             g.PullRequest,
 			SCMProvider:             g.SCMProvider,
 		}
@@ -376,6 +409,59 @@ func expandApplicationSetMergeGenerator(mg interface{}, featureMultipleApplicati
 	return asg, nil
 }
 
+func expandApplicationSetPluginGenerator(pg interface{}, featureMultipleApplicationSourcesSupported bool) (*application.ApplicationSetGenerator, error) {
+	p := pg.(map[string]interface{})
+
+	asg := &application.ApplicationSetGenerator{
+		Plugin: &application.PluginGenerator{
+			ConfigMapRef: application.PluginConfigMapRef{
+				Name: p["config_map_ref"].(string),
+			},
+		},
+	}
+
+	if v, ok := p["input"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		in := v[0].(map[string]interface{})
+
+		if ps, ok := in["parameters"].(map[string]interface{}); ok && len(ps) > 0 {
+			asg.Plugin.Input.Parameters = make(application.PluginParameters, len(ps))
+
+			for k, v := range ps {
+				raw := []byte(v.(string))
+				if !json.Valid(raw) {
+					return nil, fmt.Errorf("plugin input parameter %s is not valid JSON, use jsonencode() to pass a string: %s", k, v)
+				}
+
+				asg.Plugin.Input.Parameters[k] = apiextensionsv1.JSON{Raw: raw}
+			}
+		}
+	}
+
+	if v, ok := p["requeue_after_seconds"].(string); ok && v != "" {
+		ras, err := convertStringToInt64Pointer(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert requeue_after_seconds to *int64: %w", err)
+		}
+
+		asg.Plugin.RequeueAfterSeconds = ras
+	}
+
+	if v, ok := p["template"].([]interface{}); ok && len(v) > 0 {
+		temp, err := expandApplicationSetTemplate(v[0], featureMultipleApplicationSourcesSupported)
+		if err != nil {
+			return nil, err
+		}
+
+		asg.Plugin.Template = temp
+	}
+
+	if v, ok := p["values"]; ok {
+		asg.Plugin.Values = expandStringMap(v.(map[string]interface{}))
+	}
+
+	return asg, nil
+}
+
 func expandApplicationSetPullRequestGeneratorGenerator(mg interface{}, featureMultipleApplicationSourcesSupported bool) (*application.ApplicationSetGenerator, error) {
 	asg := &application.ApplicationSetGenerator{
 		PullRequest: &application.PullRequestGenerator{},
@@ -383,7 +469,9 @@ func expandApplicationSetPullRequestGeneratorGenerator(mg interface{}, featureMu
 
 	m := mg.(map[string]interface{})
 
-	if v, ok := m["bitbucket_server"].([]interface{}); ok && len(v) > 0 {
+	if v, ok := m["bitbucket"].([]interface{}); ok && len(v) > 0 {
+		asg.PullRequest.Bitbucket = expandApplicationSetPullRequestGeneratorBitbucket(v[0].(map[string]interface{}))
+	} else if v, ok := m["bitbucket_server"].([]interface{}); ok && len(v) > 0 {
 		asg.PullRequest.BitbucketServer = expandApplicationSetPullRequestGeneratorBitbucketServer(v[0].(map[string]interface{}))
 	} else if v, ok := m["gitea"].([]interface{}); ok && len(v) > 0 {
 		asg.PullRequest.Gitea = expandApplicationSetPullRequestGeneratorGitea(v[0].(map[string]interface{}))
@@ -418,6 +506,38 @@ func expandApplicationSetPullRequestGeneratorGenerator(mg interface{}, featureMu
 	return asg, nil
 }
 
+func expandApplicationSetPullRequestGeneratorBitbucket(b map[string]interface{}) *application.PullRequestGeneratorBitbucket {
+	prgb := &application.PullRequestGeneratorBitbucket{
+		API:   b["api"].(string),
+		Owner: b["owner"].(string),
+		Repo:  b["repo"].(string),
+	}
+
+	if v, ok := b["basic_auth"].([]interface{}); ok && len(v) > 0 {
+		ba := v[0].(map[string]interface{})
+
+		prgb.BasicAuth = &application.BasicAuthBitbucketServer{
+			Username: ba["username"].(string),
+		}
+
+		if pr, ok := ba["password_ref"].([]interface{}); ok && len(pr) > 0 {
+			prgb.BasicAuth.PasswordRef = expandSecretRef(pr[0].(map[string]interface{}))
+		}
+	}
+
+	if v, ok := b["bearer_token"].([]interface{}); ok && len(v) > 0 {
+		bt := v[0].(map[string]interface{})
+
+		prgb.BearerToken = &application.BearerTokenBitbucketCloud{}
+
+		if tr, ok := bt["token_ref"].([]interface{}); ok && len(tr) > 0 {
+			prgb.BearerToken.TokenRef = expandSecretRef(tr[0].(map[string]interface{}))
+		}
+	}
+
+	return prgb
+}
+
 func expandApplicationSetPullRequestGeneratorBitbucketServer(bs map[string]interface{}) *application.PullRequestGeneratorBitbucketServer {
 	spgbs := &application.PullRequestGeneratorBitbucketServer{
 		API:     bs["api"].(string),
@@ -479,6 +599,7 @@ func expandApplicationSetPullRequestGeneratorGithub(g map[string]interface{}) *a
 func expandApplicationSetPullRequestGeneratorGitlab(g map[string]interface{}) *application.PullRequestGeneratorGitLab {
 	spgg := &application.PullRequestGeneratorGitLab{
 		API:              g["api"].(string),
+		Insecure:         g["insecure"].(bool),
 		Project:          g["project"].(string),
 		PullRequestState: g["pull_request_state"].(string),
 	}
@@ -507,6 +628,10 @@ func expandApplicationSetPullRequestGeneratorFilters(fs []interface{}) []applica
 			spgf.BranchMatch = &bm
 		}
 
+		if tbm, ok := f["target_branch_match"].(string); ok && tbm != "" {
+			spgf.TargetBranchMatch = &tbm
+		}
+
 		prgfs[i] = spgf
 	}
 
@@ -648,6 +773,11 @@ func expandApplicationSetSCMProviderGitlab(g map[string]interface{}) *applicatio
 		API:              g["api"].(string),
 		IncludeSubgroups: g["include_subgroups"].(bool),
 		Group:            g["group"].(string),
+		Insecure:         g["insecure"].(bool),
+	}
+
+	if v, ok := g["include_shared_projects"].(bool); ok {
+		spgg.IncludeSharedProjects = &v
 	}
 
 	if v, ok := g["token_ref"].([]interface{}); ok && len(v) > 0 {
@@ -871,11 +1001,21 @@ func expandApplicationSetTemplateMeta(meta interface{}) (metadata application.Ap
 	return metadata, nil
 }
 
-func expandApplicationSetIgnoreDifferences(ids []interface{}, featureApplicationSetIgnoreApplicationDifferences bool) (result []application.ApplicationSetResourceIgnoreDifferences) {
-	if !featureApplicationSetIgnoreApplicationDifferences {
-		return
+func expandApplicationSetPreservedFields(pf map[string]interface{}) *application.ApplicationPreservedFields {
+	aspf := &application.ApplicationPreservedFields{}
+
+	if v, ok := pf["annotations"].([]interface{}); ok && len(v) > 0 {
+		aspf.Annotations = expandStringList(v)
 	}
 
+	if v, ok := pf["labels"].([]interface{}); ok && len(v) > 0 {
+		aspf.Labels = expandStringList(v)
+	}
+
+	return aspf
+}
+
+func expandApplicationSetIgnoreDifferences(ids []interface{}) (result []application.ApplicationSetResourceIgnoreDifferences) {
 	for _, _id := range ids {
 		id := _id.(map[string]interface{})
 
@@ -924,6 +1064,44 @@ func flattenApplicationSet(as *application.ApplicationSet, d *schema.ResourceDat
 	return nil
 }
 
+func flattenApplicationSetApplications(apps []application.Application, statuses []application.ApplicationSetApplicationStatus) []map[string]interface{} {
+	// Progressive sync status is only reported when a RollingSync strategy
+	// is configured, and is keyed by application name
+	rss := make(map[string]application.ApplicationSetApplicationStatus, len(statuses))
+	for _, s := range statuses {
+		rss[s.Application] = s
+	}
+
+	sort.Slice(apps, func(i, j int) bool {
+		if apps[i].Namespace != apps[j].Namespace {
+			return apps[i].Namespace < apps[j].Namespace
+		}
+
+		return apps[i].Name < apps[j].Name
+	})
+
+	as := make([]map[string]interface{}, len(apps))
+
+	for i, app := range apps {
+		as[i] = map[string]interface{}{
+			"name":               app.Name,
+			"namespace":          app.Namespace,
+			"destination_server": app.Spec.Destination.Server,
+			"destination_name":   app.Spec.Destination.Name,
+			"health_status":      string(app.Status.Health.Status),
+			"sync_status":        string(app.Status.Sync.Status),
+		}
+
+		if rs, ok := rss[app.Name]; ok {
+			as[i]["rolling_sync_step"] = rs.Step
+			as[i]["rolling_sync_status"] = rs.Status
+			as[i]["rolling_sync_message"] = rs.Message
+		}
+	}
+
+	return as
+}
+
 func flattenApplicationSetSpec(s application.ApplicationSetSpec) ([]map[string]interface{}, error) {
 	generators := make([]interface{}, len(s.Generators))
 
@@ -950,13 +1128,34 @@ func flattenApplicationSetSpec(s application.ApplicationSetSpec) ([]map[string]i
 		spec["sync_policy"] = flattenApplicationSetSyncPolicy(*s.SyncPolicy)
 	}
 
+	if s.PreservedFields != nil {
+		spec["preserved_fields"] = flattenApplicationSetPreservedFields(*s.PreservedFields)
+	}
+
 	if s.IgnoreApplicationDifferences != nil {
 		spec["ignore_application_differences"] = flattenApplicationSetIgnoreDifferences(s.IgnoreApplicationDifferences)
 	}
 
+	if len(s.GoTemplateOptions) > 0 {
+		spec["go_template_options"] = newStringSet(schema.HashString, s.GoTemplateOptions)
+	}
+
+	if s.TemplatePatch != nil {
+		spec["template_patch"] = *s.TemplatePatch
+	}
+
 	return []map[string]interface{}{spec}, nil
 }
 
+func flattenApplicationSetPreservedFields(aspf application.ApplicationPreservedFields) []map[string]interface{} {
+	pf := map[string]interface{}{
+		"annotations": aspf.Annotations,
+		"labels":      aspf.Labels,
+	}
+
+	return []map[string]interface{}{pf}
+}
+
 func flattenApplicationSetIgnoreDifferences(ids application.ApplicationSetIgnoreDifferences) (result []map[string]interface{}) {
 	for _, id := range ids {
 		result = append(result, map[string]interface{}{
@@ -1002,6 +1201,8 @@ func flattenGenerator(g application.ApplicationSetGenerator) (map[string]interfa
 		generator["scm_provider"] = flattenApplicationSetSCMProviderGenerator(g.SCMProvider)
 	} else if g.PullRequest != nil {
 		generator["pull_request"] = flattenApplicationSetPullRequestGenerator(g.PullRequest)
+	} else if g.Plugin != nil {
+		generator["plugin"] = flattenApplicationSetPluginGenerator(g.Plugin)
 	}
 
 	if g.Selector != nil {
@@ -1044,6 +1245,11 @@ func flattenApplicationSetGitGenerator(gg *application.GitGenerator) []map[strin
 		"revision":          gg.Revision,
 		"path_param_prefix": gg.PathParamPrefix,
 		"template":          flattenApplicationSetTemplate(gg.Template),
+		"values":            gg.Values,
+	}
+
+	if gg.RequeueAfterSeconds != nil {
+		g["requeue_after_seconds"] = convertInt64PointerToString(gg.RequeueAfterSeconds)
 	}
 
 	if len(gg.Directories) > 0 {
@@ -1087,8 +1293,9 @@ func flattenApplicationSetListGenerator(lg *application.ListGenerator) ([]map[st
 	}
 
 	g := map[string]interface{}{
-		"elements": elements,
-		"template": flattenApplicationSetTemplate(lg.Template),
+		"elements":      elements,
+		"elements_yaml": lg.ElementsYaml,
+		"template":      flattenApplicationSetTemplate(lg.Template),
 	}
 
 	return []map[string]interface{}{g}, nil
@@ -1135,10 +1342,39 @@ func flattenApplicationSetMergeGenerator(mg *application.MergeGenerator) ([]map[
 	return []map[string]interface{}{g}, nil
 }
 
+func flattenApplicationSetPluginGenerator(p *application.PluginGenerator) []map[string]interface{} {
+	g := map[string]interface{}{
+		"config_map_ref": p.ConfigMapRef.Name,
+		"template":       flattenApplicationSetTemplate(p.Template),
+		"values":         p.Values,
+	}
+
+	if len(p.Input.Parameters) > 0 {
+		parameters := make(map[string]string, len(p.Input.Parameters))
+		for k, v := range p.Input.Parameters {
+			parameters[k] = string(v.Raw)
+		}
+
+		g["input"] = []map[string]interface{}{
+			{
+				"parameters": parameters,
+			},
+		}
+	}
+
+	if p.RequeueAfterSeconds != nil {
+		g["requeue_after_seconds"] = convertInt64PointerToString(p.RequeueAfterSeconds)
+	}
+
+	return []map[string]interface{}{g}
+}
+
 func flattenApplicationSetPullRequestGenerator(prg *application.PullRequestGenerator) []map[string]interface{} {
 	g := map[string]interface{}{}
 
-	if prg.BitbucketServer != nil {
+	if prg.Bitbucket != nil {
+		g["bitbucket"] = flattenApplicationSetPullRequestGeneratorBitbucket(prg.Bitbucket)
+	} else if prg.BitbucketServer != nil {
 		g["bitbucket_server"] = flattenApplicationSetPullRequestGeneratorBitbucketServer(prg.BitbucketServer)
 	} else if prg.Gitea != nil {
 		g["gitea"] = flattenApplicationSetPullRequestGeneratorGitea(prg.Gitea)
@@ -1161,6 +1397,36 @@ func flattenApplicationSetPullRequestGenerator(prg *application.PullRequestGener
 	return []map[string]interface{}{g}
 }
 
+func flattenApplicationSetPullRequestGeneratorBitbucket(prgb *application.PullRequestGeneratorBitbucket) []map[string]interface{} {
+	bb := map[string]interface{}{
+		"api":   prgb.API,
+		"owner": prgb.Owner,
+		"repo":  prgb.Repo,
+	}
+
+	if prgb.BasicAuth != nil {
+		ba := map[string]interface{}{
+			"username": prgb.BasicAuth.Username,
+		}
+
+		if prgb.BasicAuth.PasswordRef != nil {
+			ba["password_ref"] = flattenSecretRef(*prgb.BasicAuth.PasswordRef)
+		}
+
+		bb["basic_auth"] = []map[string]interface{}{ba}
+	}
+
+	if prgb.BearerToken != nil && prgb.BearerToken.TokenRef != nil {
+		bb["bearer_token"] = []map[string]interface{}{
+			{
+				"token_ref": flattenSecretRef(*prgb.BearerToken.TokenRef),
+			},
+		}
+	}
+
+	return []map[string]interface{}{bb}
+}
+
 func flattenApplicationSetPullRequestGeneratorBitbucketServer(prgbs *application.PullRequestGeneratorBitbucketServer) []map[string]interface{} {
 	bb := map[string]interface{}{
 		"api":     prgbs.API,
@@ -1220,6 +1486,7 @@ func flattenApplicationSetPullRequestGeneratorGithub(prgg *application.PullReque
 func flattenApplicationSetPullRequestGeneratorGitlab(prgg *application.PullRequestGeneratorGitLab) []map[string]interface{} {
 	g := map[string]interface{}{
 		"api":                prgg.API,
+		"insecure":           prgg.Insecure,
 		"project":            prgg.Project,
 		"pull_request_state": prgg.PullRequestState,
 	}
@@ -1244,6 +1511,10 @@ func flattenApplicationSetPullRequestGeneratorFilter(spgfs []application.PullReq
 		if v.BranchMatch != nil {
 			fs[i]["branch_match"] = *v.BranchMatch
 		}
+
+		if v.TargetBranchMatch != nil {
+			fs[i]["target_branch_match"] = *v.TargetBranchMatch
+		}
 	}
 
 	return fs
@@ -1368,8 +1639,12 @@ func flattenApplicationSetSCMProviderGeneratorGitlab(spgg *application.SCMProvid
 		"api":               spgg.API,
 		"group":             spgg.Group,
 		"include_subgroups": spgg.IncludeSubgroups,
+		"insecure":          spgg.Insecure,
 	}
 
+	// ArgoCD includes shared projects unless told otherwise
+	g["include_shared_projects"] = spgg.IncludeSharedProjects == nil || *spgg.IncludeSharedProjects
+
 	if spgg.TokenRef != nil {
 		g["token_ref"] = flattenSecretRef(*spgg.TokenRef)
 	}
@@ -1451,6 +1726,8 @@ func flattenNestedGenerator(g application.ApplicationSetNestedGenerator) (map[st
 		generator["scm_provider"] = flattenApplicationSetSCMProviderGenerator(g.SCMProvider)
 	} else if g.PullRequest != nil {
 		generator["pull_request"] = flattenApplicationSetPullRequestGenerator(g.PullRequest)
+	} else if g.Plugin != nil {
+		generator["plugin"] = flattenApplicationSetPluginGenerator(g.Plugin)
 	}
 
 	if g.Selector != nil {
@@ -0,0 +1,171 @@
+package argocd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestNormalizeStringListOrder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		configured []interface{}
+		actual     []string
+		expected   []string
+	}{
+		{
+			name:       "same order",
+			configured: []interface{}{"Validate=false", "CreateNamespace=true"},
+			actual:     []string{"Validate=false", "CreateNamespace=true"},
+			expected:   []string{"Validate=false", "CreateNamespace=true"},
+		},
+		{
+			name:       "reordered",
+			configured: []interface{}{"Validate=false", "CreateNamespace=true"},
+			actual:     []string{"CreateNamespace=true", "Validate=false"},
+			expected:   []string{"Validate=false", "CreateNamespace=true"},
+		},
+		{
+			name:       "duplicates",
+			configured: []interface{}{"A=true", "B=true", "A=true"},
+			actual:     []string{"B=true", "A=true", "A=true"},
+			expected:   []string{"A=true", "B=true", "A=true"},
+		},
+		{
+			name:       "different elements",
+			configured: []interface{}{"Validate=false", "CreateNamespace=true"},
+			actual:     []string{"CreateNamespace=true", "PruneLast=true"},
+			expected:   []string{"CreateNamespace=true", "PruneLast=true"},
+		},
+		{
+			name:       "different lengths",
+			configured: []interface{}{"Validate=false"},
+			actual:     []string{"CreateNamespace=true", "Validate=false"},
+			expected:   []string{"CreateNamespace=true", "Validate=false"},
+		},
+		{
+			name:       "nothing configured",
+			configured: nil,
+			actual:     []string{"CreateNamespace=true"},
+			expected:   []string{"CreateNamespace=true"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := normalizeStringListOrder(tt.configured, tt.actual); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("normalizeStringListOrder() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNormalizeApplicationSpecBackoffDurations(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, resourceArgoCDApplication().Schema, map[string]interface{}{
+		"spec": []interface{}{
+			map[string]interface{}{
+				"sync_policy": []interface{}{
+					map[string]interface{}{
+						"retry": []interface{}{
+							map[string]interface{}{
+								"backoff": []interface{}{
+									map[string]interface{}{
+										"duration":     "300",
+										"max_duration": "1h",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	backoff := map[string]interface{}{
+		"duration":     "5m0s",
+		"max_duration": "2h",
+	}
+
+	spec := []map[string]interface{}{
+		{
+			"sync_policy": []map[string]interface{}{
+				{
+					"retry": []map[string]interface{}{
+						{
+							"backoff": []map[string]interface{}{backoff},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	normalizeApplicationSpec(spec, d, "spec.0")
+
+	expected := map[string]interface{}{
+		"duration":     "300",
+		"max_duration": "2h",
+	}
+
+	if !reflect.DeepEqual(backoff, expected) {
+		t.Errorf("normalizeApplicationSpec() backoff = %v, want %v", backoff, expected)
+	}
+}
+
+func TestEscapeHelmParameterValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{
+			name:     "plain",
+			value:    "foo",
+			expected: "foo",
+		},
+		{
+			name:     "commas",
+			value:    "a,b,c",
+			expected: `a\,b\,c`,
+		},
+		{
+			name:     "JSON document",
+			value:    `{"a": 1, "b": [2, 3]}`,
+			expected: `{"a": 1\, "b": [2\, 3]}`,
+		},
+		{
+			name:     "backslashes",
+			value:    `line\nbreak\,`,
+			expected: `line\\nbreak\\\,`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := escapeHelmParameterValue(tt.value)
+			if got != tt.expected {
+				t.Errorf("escapeHelmParameterValue() = %q, want %q", got, tt.expected)
+			}
+
+			if u := unescapeHelmParameterValue(got); u != tt.value {
+				t.Errorf("unescapeHelmParameterValue() = %q, want %q", u, tt.value)
+			}
+		})
+	}
+}
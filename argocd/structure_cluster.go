@@ -2,11 +2,19 @@ package argocd
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
 	application "github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
+// inClusterName is the name of the cluster ArgoCD is running in, as implicitly
+// registered by ArgoCD.
+const inClusterName = "in-cluster"
+
 func expandCluster(d *schema.ResourceData) (*application.Cluster, error) {
 	cluster := &application.Cluster{}
 
@@ -18,6 +26,12 @@ func expandCluster(d *schema.ResourceData) (*application.Cluster, error) {
 		cluster.Server = v.(string)
 	}
 
+	// Keep the name of the implicit in-cluster entry, which applications may
+	// target, unless it is renamed
+	if cluster.Name == "" && isInCluster(cluster.Server) {
+		cluster.Name = inClusterName
+	}
+
 	if v, ok := d.GetOk("shard"); ok {
 		shard, err := convertStringToInt64Pointer(v.(string))
 		if err != nil {
@@ -37,10 +51,27 @@ func expandCluster(d *schema.ResourceData) (*application.Cluster, error) {
 		}
 	}
 
+	if v, ok := d.GetOk("cluster_resources"); ok {
+		cluster.ClusterResources = v.(bool)
+	}
+
 	if v, ok := d.GetOk("config"); ok {
 		cluster.Config = expandClusterConfig(v.([]interface{})[0])
 	}
 
+	if v, ok := d.GetOk("kubeconfig"); ok {
+		server, config, err := expandClusterKubeconfig(v.(string), d.Get("context").(string))
+		if err != nil {
+			return nil, err
+		}
+
+		if cluster.Server == "" {
+			cluster.Server = server
+		}
+
+		cluster.Config = config
+	}
+
 	m := expandMetadata(d)
 	cluster.Annotations = m.Annotations
 	cluster.Labels = m.Labels
@@ -65,6 +96,8 @@ func expandClusterConfig(config interface{}) application.ClusterConfig {
 				clusterConfig.AWSAuthConfig.ClusterName = v.(string)
 			case "role_arn":
 				clusterConfig.AWSAuthConfig.RoleARN = v.(string)
+			case "profile":
+				clusterConfig.AWSAuthConfig.Profile = v.(string)
 			}
 		}
 	}
@@ -130,17 +163,93 @@ func expandClusterConfig(config interface{}) application.ClusterConfig {
 	return clusterConfig
 }
 
+// expandClusterKubeconfig returns the server address and the configuration of
+// the cluster targeted by the given context of a kubeconfig (or its current
+// context when empty), as `argocd cluster add` does.
+func expandClusterKubeconfig(kubeconfig, context string) (string, application.ClusterConfig, error) {
+	var config application.ClusterConfig
+
+	c, err := clientcmd.Load([]byte(kubeconfig))
+	if err != nil {
+		return "", config, fmt.Errorf("invalid kubeconfig: %w", err)
+	}
+
+	rc, err := clientcmd.NewNonInteractiveClientConfig(*c, context, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+	if err != nil {
+		return "", config, fmt.Errorf("invalid kubeconfig: %w", err)
+	}
+
+	if rc.AuthProvider != nil {
+		return "", config, fmt.Errorf("kubeconfig auth provider %s is not supported, use an exec plugin instead", rc.AuthProvider.Name)
+	}
+
+	// Certificates and keys may be referenced as files
+	if err = rest.LoadTLSFiles(rc); err != nil {
+		return "", config, fmt.Errorf("failed to read kubeconfig TLS files: %w", err)
+	}
+
+	config.BearerToken = rc.BearerToken
+	if config.BearerToken == "" && rc.BearerTokenFile != "" {
+		t, err := os.ReadFile(rc.BearerTokenFile)
+		if err != nil {
+			return "", config, fmt.Errorf("failed to read kubeconfig token file: %w", err)
+		}
+
+		config.BearerToken = strings.TrimSpace(string(t))
+	}
+
+	config.Username = rc.Username
+	config.Password = rc.Password
+	config.TLSClientConfig = application.TLSClientConfig{
+		Insecure:   rc.Insecure,
+		ServerName: rc.ServerName,
+		CAData:     rc.CAData,
+		CertData:   rc.CertData,
+		KeyData:    rc.KeyData,
+	}
+
+	if rc.ExecProvider != nil {
+		config.ExecProviderConfig = &application.ExecProviderConfig{
+			Command:     rc.ExecProvider.Command,
+			Args:        rc.ExecProvider.Args,
+			APIVersion:  rc.ExecProvider.APIVersion,
+			InstallHint: rc.ExecProvider.InstallHint,
+		}
+
+		if len(rc.ExecProvider.Env) > 0 {
+			config.ExecProviderConfig.Env = make(map[string]string)
+
+			for _, e := range rc.ExecProvider.Env {
+				config.ExecProviderConfig.Env[e.Name] = e.Value
+			}
+		}
+	}
+
+	return strings.TrimRight(rc.Host, "/"), config, nil
+}
+
 func flattenCluster(cluster *application.Cluster, d *schema.ResourceData) error {
 	r := map[string]interface{}{
-		"name":       cluster.Name,
-		"server":     cluster.Server,
-		"namespaces": cluster.Namespaces,
-		"info":       flattenClusterInfo(cluster.Info),
-		"config":     flattenClusterConfig(cluster.Config, d),
-		"project":    cluster.Project,
+		"name":              cluster.Name,
+		"server":            cluster.Server,
+		"namespaces":        cluster.Namespaces,
+		"cluster_resources": cluster.ClusterResources,
+		"info":              flattenClusterInfo(cluster.Info),
+		"project":           cluster.Project,
+	}
+
+	// The configuration derived from a kubeconfig is not persisted, nor is the
+	// one of the in-cluster entry when it relies on the service account of ArgoCD
+	_, kubeconfigOk := d.GetOk("kubeconfig")
+	_, configOk := d.GetOk("config")
+
+	if !kubeconfigOk && (configOk || !isInCluster(cluster.Server)) {
+		r["config"] = flattenClusterConfig(cluster.Config, d)
 	}
 
-	if len(cluster.Annotations) != 0 || len(cluster.Labels) != 0 {
+	// Metadata is also persisted when configured, so that labels or
+	// annotations removed outside of Terraform are detected
+	if _, ok := d.GetOk("metadata"); ok || len(cluster.Annotations) != 0 || len(cluster.Labels) != 0 {
 		// The generic flattenMetadata function can not be used since the Cluster
 		// object does not actually have ObjectMeta, just label and annotation maps
 		r["metadata"] = flattenClusterMetadata(cluster.Annotations, cluster.Labels)
@@ -148,6 +257,8 @@ func flattenCluster(cluster *application.Cluster, d *schema.ResourceData) error
 
 	if cluster.Shard != nil {
 		r["shard"] = convertInt64PointerToString(cluster.Shard)
+	} else {
+		r["shard"] = ""
 	}
 
 	for k, v := range r {
@@ -186,6 +297,7 @@ func flattenClusterConfig(config application.ClusterConfig, d *schema.ResourceDa
 			{
 				"cluster_name": config.AWSAuthConfig.ClusterName,
 				"role_arn":     config.AWSAuthConfig.RoleARN,
+				"profile":      config.AWSAuthConfig.Profile,
 			},
 		}
 	}
@@ -0,0 +1,151 @@
+package argocd
+
+import (
+	"reflect"
+	"testing"
+
+	application "github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestExpandClusterConfigAWSAuthConfig(t *testing.T) {
+	t.Parallel()
+
+	config := expandClusterConfig(map[string]interface{}{
+		"aws_auth_config": []interface{}{
+			map[string]interface{}{
+				"cluster_name": "myekscluster",
+				"role_arn":     "",
+				"profile":      "argocd",
+			},
+		},
+	})
+
+	expected := &application.AWSAuthConfig{
+		ClusterName: "myekscluster",
+		Profile:     "argocd",
+	}
+
+	if !reflect.DeepEqual(config.AWSAuthConfig, expected) {
+		t.Fatalf("expandClusterConfig() AWSAuthConfig = %+v, want %+v", config.AWSAuthConfig, expected)
+	}
+}
+
+func TestExpandClusterKubeconfig(t *testing.T) {
+	t.Parallel()
+
+	kubeconfig := `
+apiVersion: v1
+kind: Config
+current-context: token
+clusters:
+- name: token
+  cluster:
+    server: https://token.example.com/
+    insecure-skip-tls-verify: true
+- name: exec
+  cluster:
+    server: https://exec.example.com
+    certificate-authority-data: Y2EtZGF0YQ==
+    tls-server-name: exec.internal
+users:
+- name: token
+  user:
+    token: abcdef.0123456789abcdef
+- name: exec
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: argocd-k8s-auth
+      args: ["gcp"]
+      env:
+      - name: FOO
+        value: bar
+- name: gcp
+  user:
+    auth-provider:
+      name: gcp
+contexts:
+- name: token
+  context:
+    cluster: token
+    user: token
+- name: exec
+  context:
+    cluster: exec
+    user: exec
+- name: gcp
+  context:
+    cluster: exec
+    user: gcp
+`
+
+	testCases := []struct {
+		name           string
+		context        string
+		expectedServer string
+		expectedConfig application.ClusterConfig
+		expectedErr    string
+	}{
+		{
+			name:           "current context with bearer token",
+			expectedServer: "https://token.example.com",
+			expectedConfig: application.ClusterConfig{
+				BearerToken: "abcdef.0123456789abcdef",
+				TLSClientConfig: application.TLSClientConfig{
+					Insecure: true,
+				},
+			},
+		},
+		{
+			name:           "context with exec plugin",
+			context:        "exec",
+			expectedServer: "https://exec.example.com",
+			expectedConfig: application.ClusterConfig{
+				TLSClientConfig: application.TLSClientConfig{
+					ServerName: "exec.internal",
+					CAData:     []byte("ca-data"),
+				},
+				ExecProviderConfig: &application.ExecProviderConfig{
+					Command:    "argocd-k8s-auth",
+					Args:       []string{"gcp"},
+					Env:        map[string]string{"FOO": "bar"},
+					APIVersion: "client.authentication.k8s.io/v1beta1",
+				},
+			},
+		},
+		{
+			name:        "context with auth provider",
+			context:     "gcp",
+			expectedErr: "kubeconfig auth provider gcp is not supported, use an exec plugin instead",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, config, err := expandClusterKubeconfig(kubeconfig, tc.context)
+			if tc.expectedErr != "" {
+				if err == nil || err.Error() != tc.expectedErr {
+					t.Fatalf("expandClusterKubeconfig() error = %v, want %s", err, tc.expectedErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expandClusterKubeconfig() unexpected error: %s", err)
+			}
+
+			if server != tc.expectedServer {
+				t.Errorf("expandClusterKubeconfig() server = %s, want %s", server, tc.expectedServer)
+			}
+
+			if !reflect.DeepEqual(config, tc.expectedConfig) {
+				t.Errorf("expandClusterKubeconfig() config = %+v, want %+v", config, tc.expectedConfig)
+			}
+		})
+	}
+}
@@ -3,19 +3,130 @@ package argocd
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	application "github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/oboukili/terraform-provider-argocd/internal/utils"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
 )
 
 func expandProject(d *schema.ResourceData) (metadata meta.ObjectMeta, spec application.AppProjectSpec, err error) {
 	metadata = expandMetadata(d)
+
+	if m, ok := d.GetOk("manifest"); ok {
+		spec, err = expandProjectSpecFromManifest(m.(string), metadata)
+		return
+	}
+
 	spec, err = expandProjectSpec(d)
 
 	return
 }
 
+// parseProjectManifest parses a YAML or JSON AppProject manifest.
+func parseProjectManifest(manifest string) (*application.AppProject, error) {
+	var p application.AppProject
+
+	if err := yaml.UnmarshalStrict([]byte(manifest), &p); err != nil {
+		return nil, fmt.Errorf("failed to parse project manifest: %w", err)
+	}
+
+	if p.Kind != "AppProject" {
+		return nil, fmt.Errorf("manifest kind must be AppProject, got %q", p.Kind)
+	}
+
+	if p.APIVersion != "argoproj.io/v1alpha1" {
+		return nil, fmt.Errorf("manifest apiVersion must be argoproj.io/v1alpha1, got %q", p.APIVersion)
+	}
+
+	return &p, nil
+}
+
+// expandProjectSpecFromManifest returns the spec of a project manifest, after
+// ensuring the manifest describes the project identified by metadata.
+func expandProjectSpecFromManifest(manifest string, metadata meta.ObjectMeta) (spec application.AppProjectSpec, err error) {
+	p, err := parseProjectManifest(manifest)
+	if err != nil {
+		return spec, err
+	}
+
+	if p.Name != "" && p.Name != metadata.Name {
+		return spec, fmt.Errorf("manifest metadata.name %q does not match the project name %q", p.Name, metadata.Name)
+	}
+
+	if p.Namespace != "" && metadata.Namespace != "" && p.Namespace != metadata.Namespace {
+		return spec, fmt.Errorf("manifest metadata.namespace %q does not match the project namespace %q", p.Namespace, metadata.Namespace)
+	}
+
+	for _, r := range p.Spec.Roles {
+		for _, policy := range r.Policies {
+			if err := validatePolicy(metadata.Name, r.Name, policy); err != nil {
+				return spec, err
+			}
+		}
+	}
+
+	return p.Spec, nil
+}
+
+// normalizedProjectManifestSpec returns the JSON representation of the
+// managed part of a project spec, i.e. without the JWT tokens of its roles,
+// and with role policies formatted like ArgoCD does upon saving a project.
+func normalizedProjectManifestSpec(spec application.AppProjectSpec) ([]byte, error) {
+	s := spec.DeepCopy()
+
+	for i := range s.Roles {
+		s.Roles[i].JWTTokens = nil
+
+		for j := range s.Roles[i].Policies {
+			s.Roles[i].Policies[j] = formatProjectPolicy(s.Roles[i].Policies[j])
+		}
+	}
+
+	return json.Marshal(s)
+}
+
+// flattenProjectManifest returns the live project as a YAML manifest, unless
+// every field set in the spec of the `current` manifest holds the same value
+// in the live spec (which may hold fields defaulted by ArgoCD), in which case
+// `current` is returned as-is so as to preserve user formatting.
+func flattenProjectManifest(p *application.AppProject, current string) (string, error) {
+	live, err := normalizedProjectManifestSpec(p.Spec)
+	if err != nil {
+		return "", err
+	}
+
+	if desired, err := parseProjectManifest(current); err == nil {
+		if d, err := normalizedProjectManifestSpec(desired.Spec); err == nil {
+			if ok, err := utils.JSONContains(json.RawMessage(live), json.RawMessage(d)); err == nil && ok {
+				return current, nil
+			}
+		}
+	}
+
+	var spec map[string]interface{}
+	if err = json.Unmarshal(live, &spec); err != nil {
+		return "", err
+	}
+
+	y, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "AppProject",
+		"metadata": map[string]interface{}{
+			"name":      p.Name,
+			"namespace": p.Namespace,
+		},
+		"spec": spec,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(y), nil
+}
+
 func expandProjectRoles(roles []interface{}) (projectRoles []application.ProjectRole) {
 	for _, _r := range roles {
 		r := _r.(map[string]interface{})
@@ -139,11 +250,38 @@ func expandOrphanedResourcesIgnore(ignore *schema.Set) (result []application.Orp
 
 func flattenProject(p *application.AppProject, d *schema.ResourceData) error {
 	fMetadata := flattenMetadata(p.ObjectMeta, d)
-	fSpec := flattenProjectSpec(p.Spec)
 
-	if err := d.Set("spec", fSpec); err != nil {
-		e, _ := json.MarshalIndent(fSpec, "", "\t")
-		return fmt.Errorf("error persisting spec: %s\n%s", err, e)
+	// Tokens are reported regardless of whether roles are managed
+	fRoleTokens := flattenProjectRoleTokens(p)
+
+	// Unmanaged parts of the project are not persisted to the state
+	p = p.DeepCopy()
+
+	if !d.Get("manage_roles").(bool) {
+		p.Spec.Roles = nil
+	}
+
+	if !d.Get("manage_sync_windows").(bool) {
+		p.Spec.SyncWindows = nil
+	}
+
+	if m, ok := d.GetOk("manifest"); ok {
+		fManifest, err := flattenProjectManifest(p, m.(string))
+		if err != nil {
+			return fmt.Errorf("error flattening manifest: %s", err)
+		}
+
+		if err = d.Set("manifest", fManifest); err != nil {
+			return fmt.Errorf("error persisting manifest: %s", err)
+		}
+	} else {
+		fSpec := flattenProjectSpec(p.Spec)
+		normalizeProjectSpec(fSpec, d)
+
+		if err := d.Set("spec", fSpec); err != nil {
+			e, _ := json.MarshalIndent(fSpec, "", "\t")
+			return fmt.Errorf("error persisting spec: %s\n%s", err, e)
+		}
 	}
 
 	if err := d.Set("metadata", fMetadata); err != nil {
@@ -151,9 +289,39 @@ func flattenProject(p *application.AppProject, d *schema.ResourceData) error {
 		return fmt.Errorf("error persisting metadata: %s\n%s", err, e)
 	}
 
+	if err := d.Set("role_tokens", fRoleTokens); err != nil {
+		return fmt.Errorf("error persisting role tokens: %s", err)
+	}
+
 	return nil
 }
 
+// flattenProjectRoleTokens returns the JWT tokens issued for each role of the
+// project, whether through Terraform or not.
+func flattenProjectRoleTokens(p *application.AppProject) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+
+	for _, r := range p.Spec.Roles {
+		// Tokens used to be stored in the role spec, they are now stored in
+		// the project status
+		tokens := p.Status.JWTTokensByRole[r.Name].Items
+		if len(tokens) == 0 {
+			tokens = r.JWTTokens
+		}
+
+		for _, t := range tokens {
+			result = append(result, map[string]interface{}{
+				"role":       r.Name,
+				"id":         t.ID,
+				"issued_at":  int(t.IssuedAt),
+				"expires_at": int(t.ExpiresAt),
+			})
+		}
+	}
+
+	return result
+}
+
 func flattenProjectSpec(s application.AppProjectSpec) []map[string]interface{} {
 	spec := map[string]interface{}{
 		"cluster_resource_blacklist":   flattenK8SGroupKinds(s.ClusterResourceBlacklist),
@@ -173,6 +341,86 @@ func flattenProjectSpec(s application.AppProjectSpec) []map[string]interface{} {
 	return []map[string]interface{}{spec}
 }
 
+// normalizeProjectSpec reconciles a flattened project spec with the
+// configuration, so that lists whose order does not matter (e.g. source
+// repositories or role policies) do not produce diffs when ArgoCD returns
+// them in a different order or formatting.
+func normalizeProjectSpec(spec []map[string]interface{}, d *schema.ResourceData) {
+	if len(spec) == 0 {
+		return
+	}
+
+	if repos, ok := spec[0]["source_repos"].([]string); ok {
+		configured, _ := d.Get("spec.0.source_repos").([]interface{})
+		spec[0]["source_repos"] = normalizeStringListOrder(configured, repos)
+	}
+
+	roles, ok := spec[0]["role"].([]map[string]interface{})
+	if !ok {
+		return
+	}
+
+	configuredRoles, _ := d.Get("spec.0.role").([]interface{})
+
+	for _, r := range roles {
+		for _, _cr := range configuredRoles {
+			cr, ok := _cr.(map[string]interface{})
+			if !ok || cr["name"] != r["name"] {
+				continue
+			}
+
+			if policies, ok := r["policies"].([]string); ok {
+				configured, _ := cr["policies"].([]interface{})
+				r["policies"] = normalizeProjectRolePolicies(configured, policies)
+			}
+
+			if groups, ok := r["groups"].([]string); ok {
+				configured, _ := cr["groups"].([]interface{})
+				r["groups"] = normalizeStringListOrder(configured, groups)
+			}
+		}
+	}
+}
+
+// normalizeProjectRolePolicies returns the configured policies when they are
+// equivalent to the actual ones once formatted like ArgoCD does, regardless of
+// their order, and the actual policies otherwise.
+func normalizeProjectRolePolicies(configured []interface{}, actual []string) []string {
+	raw := make([]string, 0, len(configured))
+	formatted := make([]interface{}, 0, len(configured))
+
+	for _, c := range configured {
+		p, _ := c.(string)
+		raw = append(raw, p)
+		formatted = append(formatted, formatProjectPolicy(p))
+	}
+
+	result := normalizeStringListOrder(formatted, actual)
+	if len(result) != len(formatted) {
+		return result
+	}
+
+	for i := range result {
+		if result[i] != formatted[i] {
+			return result
+		}
+	}
+
+	return raw
+}
+
+// formatProjectPolicy formats a policy like ArgoCD does upon saving a project,
+// i.e. with its components separated by a comma and a single space.
+func formatProjectPolicy(policy string) string {
+	components := strings.Split(policy, ",")
+
+	for i := range components[1:] {
+		components[i+1] = strings.Trim(components[i+1], " ")
+	}
+
+	return strings.Join(components, ", ")
+}
+
 func flattenProjectSignatureKeys(keys []application.SignatureKey) (result []string) {
 	for _, key := range keys {
 		result = append(result, key.KeyID)
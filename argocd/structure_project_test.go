@@ -0,0 +1,126 @@
+package argocd
+
+import (
+	"reflect"
+	"testing"
+
+	application "github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNormalizeProjectRolePolicies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		configured []interface{}
+		actual     []string
+		expected   []string
+	}{
+		{
+			name:       "reordered",
+			configured: []interface{}{"p, proj:foo:bar, applications, get, foo/*, allow", "p, proj:foo:bar, applications, sync, foo/*, allow"},
+			actual:     []string{"p, proj:foo:bar, applications, sync, foo/*, allow", "p, proj:foo:bar, applications, get, foo/*, allow"},
+			expected:   []string{"p, proj:foo:bar, applications, get, foo/*, allow", "p, proj:foo:bar, applications, sync, foo/*, allow"},
+		},
+		{
+			name:       "formatted by ArgoCD",
+			configured: []interface{}{"p,proj:foo:bar,applications,get,foo/*,allow"},
+			actual:     []string{"p, proj:foo:bar, applications, get, foo/*, allow"},
+			expected:   []string{"p,proj:foo:bar,applications,get,foo/*,allow"},
+		},
+		{
+			name:       "different policies",
+			configured: []interface{}{"p, proj:foo:bar, applications, get, foo/*, allow"},
+			actual:     []string{"p, proj:foo:bar, applications, sync, foo/*, allow"},
+			expected:   []string{"p, proj:foo:bar, applications, sync, foo/*, allow"},
+		},
+		{
+			name:       "nothing configured",
+			configured: nil,
+			actual:     []string{"p, proj:foo:bar, applications, get, foo/*, allow"},
+			expected:   []string{"p, proj:foo:bar, applications, get, foo/*, allow"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := normalizeProjectRolePolicies(tt.configured, tt.actual); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("normalizeProjectRolePolicies() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFlattenProjectManifest(t *testing.T) {
+	t.Parallel()
+
+	current := `apiVersion: argoproj.io/v1alpha1
+kind: AppProject
+metadata:
+  name: foo
+spec:
+  sourceRepos: ["*"]
+  roles:
+    - name: bar
+      policies: ["p,proj:foo:bar,applications,get,foo/*,allow"]
+`
+
+	tests := []struct {
+		name      string
+		live      application.AppProjectSpec
+		unchanged bool
+	}{
+		{
+			name: "identical",
+			live: application.AppProjectSpec{
+				SourceRepos: []string{"*"},
+				Roles:       []application.ProjectRole{{Name: "bar", Policies: []string{"p,proj:foo:bar,applications,get,foo/*,allow"}}},
+			},
+			unchanged: true,
+		},
+		{
+			name: "normalized and defaulted by ArgoCD",
+			live: application.AppProjectSpec{
+				SourceRepos:      []string{"*"},
+				Roles:            []application.ProjectRole{{Name: "bar", Policies: []string{"p, proj:foo:bar, applications, get, foo/*, allow"}, JWTTokens: []application.JWTToken{{IssuedAt: 1}}}},
+				SourceNamespaces: []string{"argocd"},
+			},
+			unchanged: true,
+		},
+		{
+			name: "drifted",
+			live: application.AppProjectSpec{
+				SourceRepos: []string{"https://github.com/foo/bar.git"},
+				Roles:       []application.ProjectRole{{Name: "bar", Policies: []string{"p, proj:foo:bar, applications, get, foo/*, allow"}}},
+			},
+			unchanged: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &application.AppProject{
+				ObjectMeta: meta.ObjectMeta{Name: "foo", Namespace: "argocd"},
+				Spec:       tt.live,
+			}
+
+			got, err := flattenProjectManifest(p, current)
+			if err != nil {
+				t.Fatalf("flattenProjectManifest() error = %v", err)
+			}
+
+			if unchanged := got == current; unchanged != tt.unchanged {
+				t.Errorf("flattenProjectManifest() = %q, want unchanged %v", got, tt.unchanged)
+			}
+		})
+	}
+}
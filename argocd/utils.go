@@ -1,10 +1,14 @@
 package argocd
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dcoppa/argo-cd/v2/server/rbacpolicy"
 	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
@@ -34,6 +38,105 @@ func convertStringToInt64Pointer(s string) (*int64, error) {
 
 	return &i, nil
 }
+
+// parseBackoffDuration parses sync retry backoff durations which, like in
+// ArgoCD, default to seconds when no unit is specified.
+func parseBackoffDuration(s string) (time.Duration, error) {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Duration(i) * time.Second, nil
+	}
+
+	return time.ParseDuration(s)
+}
+
+// equivalentBackoffDurations returns whether two backoff durations are
+// equivalent, e.g. `5m`, `5m0s` and `300`.
+func equivalentBackoffDurations(a, b string) bool {
+	x, err := parseBackoffDuration(a)
+	if err != nil {
+		return false
+	}
+
+	y, err := parseBackoffDuration(b)
+	if err != nil {
+		return false
+	}
+
+	return x == y
+}
+
+// suppressEquivalentSyncWindowSchedules suppresses diffs between sync window
+// schedules that only differ by their whitespaces, e.g. `0  22 * * *` and
+// `0 22 * * *`.
+func suppressEquivalentSyncWindowSchedules(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	return strings.Join(strings.Fields(oldValue), " ") == strings.Join(strings.Fields(newValue), " ")
+}
+
+// suppressEquivalentSyncWindowTimezones suppresses diffs between an empty
+// sync window timezone and `UTC`, which ArgoCD defaults empty timezones to.
+func suppressEquivalentSyncWindowTimezones(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	if oldValue == "" {
+		oldValue = "UTC"
+	}
+
+	if newValue == "" {
+		newValue = "UTC"
+	}
+
+	return oldValue == newValue
+}
+
+// suppressEquivalentProjectManifests suppresses diffs between project
+// manifests whose specs are semantically equivalent, e.g. that only differ by
+// their formatting.
+func suppressEquivalentProjectManifests(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	if oldValue == newValue {
+		return true
+	}
+
+	o, err := parseProjectManifest(oldValue)
+	if err != nil {
+		return false
+	}
+
+	n, err := parseProjectManifest(newValue)
+	if err != nil {
+		return false
+	}
+
+	oSpec, err := normalizedProjectManifestSpec(o.Spec)
+	if err != nil {
+		return false
+	}
+
+	nSpec, err := normalizedProjectManifestSpec(n.Spec)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(oSpec, nSpec)
+}
+
+// suppressEquivalentJSON suppresses diffs between semantically equivalent
+// JSON documents, e.g. that only differ by their formatting.
+func suppressEquivalentJSON(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	if oldValue == newValue {
+		return true
+	}
+
+	var o, n interface{}
+
+	if err := json.Unmarshal([]byte(oldValue), &o); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(newValue), &n); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(o, n)
+}
+
 func isKeyInMap(key string, d map[string]interface{}) bool {
 	if d == nil {
 		return false
@@ -2,16 +2,23 @@ package argocd
 
 import (
 	"fmt"
+	"path/filepath"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 	_ "time/tzdata"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
 	argocdtime "github.com/argoproj/pkg/time"
+	application "github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/gobwas/glob"
 	"github.com/robfig/cron/v3"
 	"golang.org/x/crypto/ssh"
 	apiValidation "k8s.io/apimachinery/pkg/api/validation"
 	utilValidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
 func validateMetadataLabels(value interface{}, key string) (ws []string, es []error) {
@@ -62,6 +69,16 @@ func validateMetadataName(value interface{}, key string) (ws []string, es []erro
 	return
 }
 
+func validateSecretKey(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+
+	for _, err := range utilValidation.IsConfigMapKey(v) {
+		es = append(es, fmt.Errorf("%s: invalid secret key '%s': %s", key, v, err))
+	}
+
+	return
+}
+
 func validateRoleName(value interface{}, key string) (ws []string, es []error) {
 	v := value.(string)
 
@@ -84,6 +101,71 @@ func validateGroupName(value interface{}, key string) (ws []string, es []error)
 	return
 }
 
+func validateGlob(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+
+	if _, err := glob.Compile(v); err != nil {
+		es = append(es, fmt.Errorf("%s: invalid glob pattern '%s': %s", key, v, err))
+	}
+
+	return
+}
+
+func validateGPGKeyID(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+
+	gpgKeyIDRegexp := regexp.MustCompile(`^[0-9a-fA-F]{16}$`)
+	if !gpgKeyIDRegexp.MatchString(v) {
+		es = append(es, fmt.Errorf("%s: invalid PGP key ID '%s'. Must consist of 16 hexadecimal characters", key, v))
+	}
+
+	return
+}
+
+func validateResourceGroup(value interface{}, key string) (ws []string, es []error) {
+	ws, es = validateGroupName(value, key)
+	if len(es) > 0 {
+		return
+	}
+
+	v := value.(string)
+
+	if _, err := filepath.Match(v, ""); err != nil {
+		es = append(es, fmt.Errorf("%s: invalid group pattern '%s': %s", key, v, err))
+		return
+	}
+
+	// The core group is empty, wildcard patterns are matched by ArgoCD
+	if v == "" || strings.ContainsAny(v, "*?[") {
+		return
+	}
+
+	for _, err := range utilValidation.IsDNS1123Subdomain(v) {
+		es = append(es, fmt.Errorf("%s: invalid group '%s': %s", key, v, err))
+	}
+
+	return
+}
+
+func validateResourceKind(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+
+	if _, err := filepath.Match(v, ""); err != nil {
+		es = append(es, fmt.Errorf("%s: invalid kind pattern '%s': %s", key, v, err))
+		return
+	}
+
+	if v == "" || strings.ContainsAny(v, "*?[") {
+		return
+	}
+
+	for _, err := range utilValidation.IsDNS1035Label(strings.ToLower(v)) {
+		es = append(es, fmt.Errorf("%s: invalid kind '%s': %s", key, v, err))
+	}
+
+	return
+}
+
 func validateSyncWindowKind(value interface{}, key string) (ws []string, es []error) {
 	v := value.(string)
 	if v != "allow" && v != "deny" {
@@ -93,10 +175,110 @@ func validateSyncWindowKind(value interface{}, key string) (ws []string, es []er
 	return
 }
 
+func validateHealthStatus(value interface{}, key string) (ws []string, es []error) {
+	v := health.HealthStatusCode(value.(string))
+
+	switch v {
+	case health.HealthStatusUnknown, health.HealthStatusProgressing, health.HealthStatusHealthy, health.HealthStatusSuspended, health.HealthStatusDegraded, health.HealthStatusMissing:
+	default:
+		es = append(es, fmt.Errorf("%s: health status '%s' is invalid: must be one of Unknown, Progressing, Healthy, Suspended, Degraded or Missing", key, v))
+	}
+
+	return
+}
+
+func validateApplicationConditionType(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+
+	switch v {
+	case application.ApplicationConditionDeletionError, application.ApplicationConditionInvalidSpecError, application.ApplicationConditionComparisonError, application.ApplicationConditionSyncError, application.ApplicationConditionUnknownError, application.ApplicationConditionSharedResourceWarning, application.ApplicationConditionRepeatedResourceWarning, application.ApplicationConditionExcludedResourceWarning, application.ApplicationConditionOrphanedResourceWarning:
+	default:
+		es = append(es, fmt.Errorf("%s: application condition type '%s' is invalid: must be one of DeletionError, InvalidSpecError, ComparisonError, SyncError, UnknownError, SharedResourceWarning, RepeatedResourceWarning, ExcludedResourceWarning or OrphanedResourceWarning", key, v))
+	}
+
+	return
+}
+
+func validateRefreshType(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if v != "none" && v != "normal" && v != "hard" {
+		es = append(es, fmt.Errorf("%s: refresh type '%s' is invalid: can only be none, normal or hard", key, v))
+	}
+
+	return
+}
+
+func validateDriftDetection(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if v != "none" && v != "warn" && v != "error" {
+		es = append(es, fmt.Errorf("%s: drift detection mode '%s' is invalid: can only be none, warn or error", key, v))
+	}
+
+	return
+}
+
+func validateApplicationReference(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if strings.Contains(v, "://") {
+		es = append(es, fmt.Errorf("%s: application reference '%s' is invalid: expected format is <name>:<namespace>, <namespace>/<name> or <name>", key, v))
+		return
+	}
+
+	if _, _, err := parseApplicationImportID(v); err != nil {
+		es = append(es, fmt.Errorf("%s: %s", key, err))
+	}
+
+	return
+}
+
+func validateDeletionFinalizer(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if v != "none" && v != "foreground" && v != "background" {
+		es = append(es, fmt.Errorf("%s: deletion finalizer '%s' is invalid: can only be none, foreground or background", key, v))
+	}
+
+	return
+}
+
+func validateExecProviderAPIVersion(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if v != "client.authentication.k8s.io/v1" && v != "client.authentication.k8s.io/v1beta1" {
+		es = append(es, fmt.Errorf("%s: exec provider API version '%s' is invalid: can only be client.authentication.k8s.io/v1 or client.authentication.k8s.io/v1beta1", key, v))
+	}
+
+	return
+}
+
+func validateKubeconfig(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+
+	if _, err := clientcmd.Load([]byte(v)); err != nil {
+		es = append(es, fmt.Errorf("%s: invalid kubeconfig: %s", key, err))
+	}
+
+	return
+}
+
+func validateSyncStrategy(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if v != "apply" && v != "hook" {
+		es = append(es, fmt.Errorf("%s: sync strategy '%s' is invalid: can only be apply or hook", key, v))
+	}
+
+	return
+}
+
 func validateSyncWindowSchedule(value interface{}, key string) (ws []string, es []error) {
 	v := value.(string)
 	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 
+	// The timezone prefixes supported by the cron parser would take precedence
+	// over the timezone of the sync window
+	if strings.HasPrefix(v, "TZ=") || strings.HasPrefix(v, "CRON_TZ=") {
+		es = append(es, fmt.Errorf("%s: schedule '%s' must not specify a timezone, use the timezone of the sync window instead", key, v))
+		return
+	}
+
 	if _, err := specParser.Parse(v); err != nil {
 		es = append(es, fmt.Errorf("%s: cannot parse schedule '%s': %s", key, v, err))
 	}
@@ -116,6 +298,13 @@ func validateSyncWindowDuration(value interface{}, key string) (ws []string, es
 
 func validateSyncWindowTimezone(value interface{}, key string) (ws []string, es []error) {
 	v := value.(string)
+
+	// Local would be evaluated in the timezone of the ArgoCD controller
+	if v == "Local" {
+		es = append(es, fmt.Errorf("%s: timezone '%s' is not supported, use a timezone from the IANA time zone database instead", key, v))
+		return
+	}
+
 	if _, err := time.LoadLocation(v); err != nil {
 		es = append(es, fmt.Errorf("%s: cannot parse timezone '%s': %s", key, v, err))
 	}
@@ -133,6 +322,26 @@ func validateDuration(value interface{}, key string) (ws []string, es []error) {
 	return
 }
 
+func validateBackoffDuration(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+
+	if _, err := parseBackoffDuration(v); err != nil {
+		es = append(es, fmt.Errorf("%s: invalid duration '%s': must be a number of seconds or a duration (e.g. `2m`, `1h`)", key, v))
+	}
+
+	return
+}
+
+func validateInteger(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+
+	if _, err := convertStringToInt64(v); err != nil {
+		es = append(es, fmt.Errorf("%s: invalid input '%s'. String input must match an integer, e.g.'12345'", key, v))
+	}
+
+	return
+}
+
 func validateSSHPrivateKey(value interface{}, key string) (ws []string, es []error) {
 	v := value.(string)
 
@@ -161,6 +370,75 @@ func validateIntOrStringPercentage(value interface{}, key string) (ws []string,
 
 	if !positiveIntegerOrPercentageRegexp.MatchString(v) {
 		es = append(es, fmt.Errorf("%s: invalid input '%s'. String input must match a positive integer (e.g. '100') or percentage (e.g. '20%%')", key, v))
+		return
+	}
+
+	if p, ok := strings.CutSuffix(v, "%"); ok {
+		if i, err := strconv.Atoi(p); err != nil || i > 100 {
+			es = append(es, fmt.Errorf("%s: invalid input '%s'. Percentage must not exceed 100%%", key, v))
+		}
+	}
+
+	return
+}
+
+// syncOptions lists the application level sync options known to be supported
+// by ArgoCD along with their allowed values. More info:
+// https://argo-cd.readthedocs.io/en/stable/user-guide/sync-options/
+var syncOptions = map[string][]string{
+	"ApplyOutOfSyncOnly":          {"true", "false"},
+	"CreateNamespace":             {"true", "false"},
+	"FailOnSharedResource":        {"true", "false"},
+	"Prune":                       {"true", "false", "confirm"},
+	"PruneLast":                   {"true", "false"},
+	"PrunePropagationPolicy":      {"foreground", "background", "orphan"},
+	"Replace":                     {"true", "false"},
+	"RespectIgnoreDifferences":    {"true", "false"},
+	"ServerSideApply":             {"true", "false"},
+	"SkipDryRunOnMissingResource": {"true", "false"},
+	"Validate":                    {"true", "false"},
+}
+
+func validateSyncOption(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+
+	parts := strings.SplitN(v, "=", 2)
+	if len(parts) != 2 {
+		es = append(es, fmt.Errorf("%s: invalid sync option '%s': must be of the form 'Option=value'", key, v))
+		return
+	}
+
+	allowed, ok := syncOptions[parts[0]]
+	if !ok {
+		// Newer ArgoCD versions may support options unknown to the provider
+		known := make([]string, 0, len(syncOptions))
+		for o := range syncOptions {
+			known = append(known, o)
+		}
+
+		sort.Strings(known)
+
+		ws = append(ws, fmt.Sprintf("%s: unknown sync option '%s', which may not be supported by ArgoCD (known options are %s)", key, parts[0], strings.Join(known, ", ")))
+
+		return
+	}
+
+	for _, a := range allowed {
+		if parts[1] == a {
+			return
+		}
+	}
+
+	es = append(es, fmt.Errorf("%s: invalid value '%s' for sync option '%s': must be one of %s", key, parts[1], parts[0], strings.Join(allowed, ", ")))
+
+	return
+}
+
+func validateProjectManifest(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+
+	if _, err := parseProjectManifest(v); err != nil {
+		es = append(es, fmt.Errorf("%s: %s", key, err))
 	}
 
 	return
@@ -47,3 +47,518 @@ func Test_validateSSHPrivateKey(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateSyncOption(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		value  interface{}
+		wantWs []string
+		wantEs []error
+	}{
+		{
+			name:   "Valid boolean sync option",
+			value:  "CreateNamespace=true",
+			wantEs: nil,
+		},
+		{
+			name:   "Valid non boolean sync option",
+			value:  "PrunePropagationPolicy=foreground",
+			wantEs: nil,
+		},
+		{
+			name:   "Missing value",
+			value:  "CreateNamespace",
+			wantEs: []error{fmt.Errorf("sync_options.0: invalid sync option 'CreateNamespace': must be of the form 'Option=value'")},
+		},
+		{
+			name:   "Unknown sync option",
+			value:  "CreateNamepsace=true",
+			wantWs: []string{"sync_options.0: unknown sync option 'CreateNamepsace', which may not be supported by ArgoCD (known options are ApplyOutOfSyncOnly, CreateNamespace, FailOnSharedResource, Prune, PruneLast, PrunePropagationPolicy, Replace, RespectIgnoreDifferences, ServerSideApply, SkipDryRunOnMissingResource, Validate)"},
+		},
+		{
+			name:   "Invalid value",
+			value:  "ServerSideApply=yes",
+			wantEs: []error{fmt.Errorf("sync_options.0: invalid value 'yes' for sync option 'ServerSideApply': must be one of true, false")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotWs, gotEs := validateSyncOption(tt.value, "sync_options.0")
+
+			if !reflect.DeepEqual(gotWs, tt.wantWs) {
+				t.Errorf("validateSyncOption() gotWs = %v, want %v", gotWs, tt.wantWs)
+			}
+
+			if !reflect.DeepEqual(gotEs, tt.wantEs) {
+				t.Errorf("validateSyncOption() gotEs = %v, want %v", gotEs, tt.wantEs)
+			}
+		})
+	}
+}
+
+func Test_validateBackoffDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		value  interface{}
+		wantEs []error
+	}{
+		{
+			name:   "Seconds",
+			value:  "30",
+			wantEs: nil,
+		},
+		{
+			name:   "Duration",
+			value:  "5m0s",
+			wantEs: nil,
+		},
+		{
+			name:   "Invalid duration",
+			value:  "5 minutes",
+			wantEs: []error{fmt.Errorf("duration: invalid duration '5 minutes': must be a number of seconds or a duration (e.g. `2m`, `1h`)")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, gotEs := validateBackoffDuration(tt.value, "duration")
+
+			if !reflect.DeepEqual(gotEs, tt.wantEs) {
+				t.Errorf("validateBackoffDuration() gotEs = %v, want %v", gotEs, tt.wantEs)
+			}
+		})
+	}
+}
+
+func Test_validateIntOrStringPercentage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		value  interface{}
+		wantEs []error
+	}{
+		{
+			name:   "Integer",
+			value:  "5",
+			wantEs: nil,
+		},
+		{
+			name:   "Percentage",
+			value:  "25%",
+			wantEs: nil,
+		},
+		{
+			name:   "Full percentage",
+			value:  "100%",
+			wantEs: nil,
+		},
+		{
+			name:   "Percentage above 100",
+			value:  "150%",
+			wantEs: []error{fmt.Errorf("max_update: invalid input '150%%'. Percentage must not exceed 100%%")},
+		},
+		{
+			name:   "Negative integer",
+			value:  "-1",
+			wantEs: []error{fmt.Errorf("max_update: invalid input '-1'. String input must match a positive integer (e.g. '100') or percentage (e.g. '20%%')")},
+		},
+		{
+			name:   "Invalid percentage",
+			value:  "20 %",
+			wantEs: []error{fmt.Errorf("max_update: invalid input '20 %%'. String input must match a positive integer (e.g. '100') or percentage (e.g. '20%%')")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, gotEs := validateIntOrStringPercentage(tt.value, "max_update")
+
+			if !reflect.DeepEqual(gotEs, tt.wantEs) {
+				t.Errorf("validateIntOrStringPercentage() gotEs = %v, want %v", gotEs, tt.wantEs)
+			}
+		})
+	}
+}
+
+func Test_validateSecretKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		value  interface{}
+		wantEs []error
+	}{
+		{
+			name:   "Valid key",
+			value:  "token",
+			wantEs: nil,
+		},
+		{
+			name:   "Valid dotted key",
+			value:  "github.token_1",
+			wantEs: nil,
+		},
+		{
+			name:   "Invalid key",
+			value:  "github/token",
+			wantEs: []error{fmt.Errorf("key: invalid secret key 'github/token': a valid config key must consist of alphanumeric characters, '-', '_' or '.' (e.g. 'key.name',  or 'KEY_NAME',  or 'key-name', regex used for validation is '[-._a-zA-Z0-9]+')")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, gotEs := validateSecretKey(tt.value, "key")
+
+			if !reflect.DeepEqual(gotEs, tt.wantEs) {
+				t.Errorf("validateSecretKey() gotEs = %v, want %v", gotEs, tt.wantEs)
+			}
+		})
+	}
+}
+
+func Test_validateGlob(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		value  interface{}
+		wantEs []error
+	}{
+		{
+			name:   "Literal namespace",
+			value:  "team-foo",
+			wantEs: nil,
+		},
+		{
+			name:   "Wildcard",
+			value:  "team-*",
+			wantEs: nil,
+		},
+		{
+			name:   "Invalid pattern",
+			value:  "team-[a-",
+			wantEs: []error{fmt.Errorf("key: invalid glob pattern 'team-[a-': unexpected end of input")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, gotEs := validateGlob(tt.value, "key")
+
+			if !reflect.DeepEqual(gotEs, tt.wantEs) {
+				t.Errorf("validateGlob() gotEs = %v, want %v", gotEs, tt.wantEs)
+			}
+		})
+	}
+}
+
+func Test_validateGPGKeyID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		value  interface{}
+		wantEs []error
+	}{
+		{
+			name:   "Valid key ID",
+			value:  "4AEE18F83AFDEB23",
+			wantEs: nil,
+		},
+		{
+			name:   "Valid lowercase key ID",
+			value:  "4aee18f83afdeb23",
+			wantEs: nil,
+		},
+		{
+			name:   "Fingerprint",
+			value:  "9D2B68D6A6E2A8F3B8F5C4C04AEE18F83AFDEB23",
+			wantEs: []error{fmt.Errorf("key: invalid PGP key ID '9D2B68D6A6E2A8F3B8F5C4C04AEE18F83AFDEB23'. Must consist of 16 hexadecimal characters")},
+		},
+		{
+			name:   "Non hexadecimal key ID",
+			value:  "4AEE18F83AFDEB2Z",
+			wantEs: []error{fmt.Errorf("key: invalid PGP key ID '4AEE18F83AFDEB2Z'. Must consist of 16 hexadecimal characters")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, gotEs := validateGPGKeyID(tt.value, "key")
+
+			if !reflect.DeepEqual(gotEs, tt.wantEs) {
+				t.Errorf("validateGPGKeyID() gotEs = %v, want %v", gotEs, tt.wantEs)
+			}
+		})
+	}
+}
+
+func Test_validateResourceGroup(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		value  interface{}
+		wantEs []error
+	}{
+		{
+			name:   "Core group",
+			value:  "",
+			wantEs: nil,
+		},
+		{
+			name:   "Named group",
+			value:  "rbac.authorization.k8s.io",
+			wantEs: nil,
+		},
+		{
+			name:   "Wildcard",
+			value:  "*",
+			wantEs: nil,
+		},
+		{
+			name:   "Wildcard suffix",
+			value:  "*.k8s.io",
+			wantEs: nil,
+		},
+		{
+			name:   "Invalid pattern",
+			value:  "apps[",
+			wantEs: []error{fmt.Errorf("key: invalid group pattern 'apps[': syntax error in pattern")},
+		},
+		{
+			name:   "Invalid group",
+			value:  "Apps_v1",
+			wantEs: []error{fmt.Errorf("key: invalid group 'Apps_v1': a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')")},
+		},
+		{
+			name:   "Invalid characters",
+			value:  "apps,rbac",
+			wantEs: []error{fmt.Errorf("key: group 'apps,rbac' contains invalid characters")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, gotEs := validateResourceGroup(tt.value, "key")
+
+			if !reflect.DeepEqual(gotEs, tt.wantEs) {
+				t.Errorf("validateResourceGroup() gotEs = %v, want %v", gotEs, tt.wantEs)
+			}
+		})
+	}
+}
+
+func Test_validateResourceKind(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		value  interface{}
+		wantEs []error
+	}{
+		{
+			name:   "Kind",
+			value:  "ClusterRole",
+			wantEs: nil,
+		},
+		{
+			name:   "Wildcard",
+			value:  "*",
+			wantEs: nil,
+		},
+		{
+			name:   "Wildcard prefix",
+			value:  "Cluster*",
+			wantEs: nil,
+		},
+		{
+			name:   "Invalid pattern",
+			value:  "Cluster[",
+			wantEs: []error{fmt.Errorf("key: invalid kind pattern 'Cluster[': syntax error in pattern")},
+		},
+		{
+			name:   "Invalid kind",
+			value:  "Cluster_Role",
+			wantEs: []error{fmt.Errorf("key: invalid kind 'Cluster_Role': a DNS-1035 label must consist of lower case alphanumeric characters or '-', start with an alphabetic character, and end with an alphanumeric character (e.g. 'my-name',  or 'abc-123', regex used for validation is '[a-z]([-a-z0-9]*[a-z0-9])?')")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, gotEs := validateResourceKind(tt.value, "key")
+
+			if !reflect.DeepEqual(gotEs, tt.wantEs) {
+				t.Errorf("validateResourceKind() gotEs = %v, want %v", gotEs, tt.wantEs)
+			}
+		})
+	}
+}
+
+func Test_validateSyncWindowSchedule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		value  interface{}
+		wantEs []error
+	}{
+		{
+			name:   "Valid schedule",
+			value:  "0 22 * * *",
+			wantEs: nil,
+		},
+		{
+			name:   "Out of range hour",
+			value:  "0 25 * * *",
+			wantEs: []error{fmt.Errorf("key: cannot parse schedule '0 25 * * *': end of range (25) above maximum (23): 25")},
+		},
+		{
+			name:   "Descriptor",
+			value:  "@daily",
+			wantEs: []error{fmt.Errorf("key: cannot parse schedule '@daily': parser does not accept descriptors: @daily")},
+		},
+		{
+			name:   "Timezone prefix",
+			value:  "CRON_TZ=Europe/Paris 0 22 * * *",
+			wantEs: []error{fmt.Errorf("key: schedule 'CRON_TZ=Europe/Paris 0 22 * * *' must not specify a timezone, use the timezone of the sync window instead")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, gotEs := validateSyncWindowSchedule(tt.value, "key")
+
+			if !reflect.DeepEqual(gotEs, tt.wantEs) {
+				t.Errorf("validateSyncWindowSchedule() gotEs = %v, want %v", gotEs, tt.wantEs)
+			}
+		})
+	}
+}
+
+func Test_validateSyncWindowTimezone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		value  interface{}
+		wantEs []error
+	}{
+		{
+			name:   "UTC",
+			value:  "UTC",
+			wantEs: nil,
+		},
+		{
+			name:   "IANA timezone",
+			value:  "Europe/Paris",
+			wantEs: nil,
+		},
+		{
+			name:   "Local timezone",
+			value:  "Local",
+			wantEs: []error{fmt.Errorf("key: timezone 'Local' is not supported, use a timezone from the IANA time zone database instead")},
+		},
+		{
+			name:   "Unknown timezone",
+			value:  "Mars/Olympus",
+			wantEs: []error{fmt.Errorf("key: cannot parse timezone 'Mars/Olympus': unknown time zone Mars/Olympus")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, gotEs := validateSyncWindowTimezone(tt.value, "key")
+
+			if !reflect.DeepEqual(gotEs, tt.wantEs) {
+				t.Errorf("validateSyncWindowTimezone() gotEs = %v, want %v", gotEs, tt.wantEs)
+			}
+		})
+	}
+}
+
+func Test_validateExecProviderAPIVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		value  interface{}
+		wantEs []error
+	}{
+		{
+			name:   "v1",
+			value:  "client.authentication.k8s.io/v1",
+			wantEs: nil,
+		},
+		{
+			name:   "v1beta1",
+			value:  "client.authentication.k8s.io/v1beta1",
+			wantEs: nil,
+		},
+		{
+			name:   "Removed v1alpha1",
+			value:  "client.authentication.k8s.io/v1alpha1",
+			wantEs: []error{fmt.Errorf("key: exec provider API version 'client.authentication.k8s.io/v1alpha1' is invalid: can only be client.authentication.k8s.io/v1 or client.authentication.k8s.io/v1beta1")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, gotEs := validateExecProviderAPIVersion(tt.value, "key")
+
+			if !reflect.DeepEqual(gotEs, tt.wantEs) {
+				t.Errorf("validateExecProviderAPIVersion() gotEs = %v, want %v", gotEs, tt.wantEs)
+			}
+		})
+	}
+}
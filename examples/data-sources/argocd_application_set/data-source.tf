@@ -0,0 +1,6 @@
+data "argocd_application_set" "foo" {
+  metadata = {
+    name      = "foo"
+    namespace = "argocd"
+  }
+}
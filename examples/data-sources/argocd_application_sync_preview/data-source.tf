@@ -0,0 +1,9 @@
+data "argocd_application_sync_preview" "foo" {
+  name      = "foo"
+  namespace = "argocd"
+  revision  = "v1.2.0"
+}
+
+output "foo_changes" {
+  value = [for r in data.argocd_application_sync_preview.foo.resources : "${r.action} ${r.kind}/${r.name}"]
+}
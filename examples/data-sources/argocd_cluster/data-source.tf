@@ -0,0 +1,14 @@
+data "argocd_cluster" "production" {
+  name = "production"
+}
+
+locals {
+  production_supports_gateway_api = contains(
+    data.argocd_cluster.production.info.api_versions,
+    "gateway.networking.k8s.io/v1/HTTPRoute",
+  )
+}
+
+output "production_is_healthy" {
+  value = data.argocd_cluster.production.info.connection_state.status == "Successful"
+}
@@ -0,0 +1,29 @@
+data "argocd_clusters" "production" {
+  name_prefix = "prod-"
+
+  labels = {
+    environment = "production"
+  }
+}
+
+resource "argocd_application" "monitoring" {
+  for_each = { for c in data.argocd_clusters.production.clusters : c.name => c.server }
+
+  metadata {
+    name      = "monitoring-${each.key}"
+    namespace = "argocd"
+  }
+
+  spec {
+    source {
+      repo_url        = "https://prometheus-community.github.io/helm-charts"
+      chart           = "kube-prometheus-stack"
+      target_revision = "58.1.3"
+    }
+
+    destination {
+      server    = each.value
+      namespace = "monitoring"
+    }
+  }
+}
@@ -0,0 +1,27 @@
+data "argocd_project" "shared" {
+  metadata = {
+    name = "shared"
+  }
+}
+
+resource "argocd_application" "foo" {
+  metadata {
+    name      = "foo"
+    namespace = "argocd"
+  }
+
+  spec {
+    project = data.argocd_project.shared.metadata.name
+
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps.git"
+      path            = "guestbook"
+      target_revision = "HEAD"
+    }
+
+    destination {
+      server    = data.argocd_project.shared.spec.destination[0].server
+      namespace = "foo"
+    }
+  }
+}
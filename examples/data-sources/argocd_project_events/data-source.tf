@@ -0,0 +1,11 @@
+data "argocd_project_events" "foo" {
+  project              = "foo"
+  include_applications = true
+}
+
+output "project_warnings" {
+  value = [
+    for e in data.argocd_project_events.foo.events : "${e.object_kind}/${e.object_name}: ${e.message}"
+    if e.type == "Warning"
+  ]
+}
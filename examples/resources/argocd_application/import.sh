@@ -1,3 +1,8 @@
-# ArgoCD applications can be imported using an id consisting of `{name}:{namespace}`. E.g.
+# ArgoCD applications can be imported using an id consisting of `{name}:{namespace}`,
+# `{namespace}/{name}`, a bare `{name}` (for applications in the ArgoCD namespace)
+# or the URL of the application in the ArgoCD UI. E.g.
 
-terraform import argocd_application.myapp myapp:argocd
\ No newline at end of file
+terraform import argocd_application.myapp myapp:argocd
+terraform import argocd_application.myapp argocd/myapp
+terraform import argocd_application.myapp myapp
+terraform import argocd_application.myapp https://argocd.example.com/applications/argocd/myapp
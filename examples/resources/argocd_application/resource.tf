@@ -11,6 +11,12 @@ resource "argocd_application" "kustomize" {
   cascade = false # disable cascading deletion
   wait    = true
 
+  # Only wait for the critical resources to become healthy
+  wait_for {
+    kind = "Deployment"
+    name = "foo-the-deployment-bar"
+  }
+
   spec {
     project = "myproject"
 
@@ -72,6 +78,17 @@ resource "argocd_application" "kustomize" {
         ".spec.template.spec.metadata.labels.bar",
       ]
     }
+
+    # Links displayed in the ArgoCD UI
+    info {
+      name  = "Runbook"
+      value = "https://runbooks.example.com/kustomize-app"
+    }
+
+    info {
+      name  = "Dashboard"
+      value = "https://grafana.example.com/d/kustomize-app"
+    }
   }
 }
 
@@ -148,3 +165,49 @@ resource "argocd_application" "multiple_sources" {
     }
   }
 }
+
+# Jsonnet application with external variables, top-level arguments and libraries
+resource "argocd_application" "jsonnet" {
+  metadata {
+    name      = "jsonnet-app"
+    namespace = "argocd"
+  }
+
+  spec {
+    project = "default"
+
+    source {
+      repo_url        = "https://github.com/argoproj/argocd-example-apps.git"
+      path            = "jsonnet-guestbook"
+      target_revision = "HEAD"
+
+      directory {
+        jsonnet {
+          ext_var {
+            name  = "environment"
+            value = "production"
+          }
+
+          ext_var {
+            name  = "replicas"
+            value = "3"
+            code  = true
+          }
+
+          tla {
+            name  = "containerPort"
+            value = "80"
+            code  = true
+          }
+
+          libs = ["vendor"]
+        }
+      }
+    }
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "jsonnet"
+    }
+  }
+}
@@ -0,0 +1,6 @@
+# ArgoCD application sets can be imported using an id consisting of `{name}:{namespace}`,
+# `{namespace}/{name}` or a bare `{name}` (for application sets in the ArgoCD namespace). E.g.
+
+terraform import argocd_application_set.myappset myappset:mynamespace
+terraform import argocd_application_set.myappset mynamespace/myappset
+terraform import argocd_application_set.myappset myappset
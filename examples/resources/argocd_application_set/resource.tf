@@ -340,6 +340,47 @@ resource "argocd_application_set" "merge" {
   }
 }
 
+# Plugin Generator
+resource "argocd_application_set" "plugin" {
+  metadata {
+    name = "plugin"
+  }
+
+  spec {
+    generator {
+      plugin {
+        config_map_ref = "my-plugin"
+
+        input {
+          parameters = {
+            environment = jsonencode("production")
+            regions     = jsonencode(["eu-west-1", "us-east-1"])
+          }
+        }
+      }
+    }
+
+    template {
+      metadata {
+        name = "{{name}}-guestbook"
+      }
+
+      spec {
+        source {
+          repo_url        = "https://github.com/argoproj/argocd-example-apps/"
+          target_revision = "HEAD"
+          path            = "guestbook"
+        }
+
+        destination {
+          server    = "https://kubernetes.default.svc"
+          namespace = "default"
+        }
+      }
+    }
+  }
+}
+
 # Pull Request Generator - GitHub
 resource "argocd_application_set" "pr_github" {
   metadata {
@@ -0,0 +1,3 @@
+# ArgoCD application sets can be imported using an id consisting of `{name}:{namespace}`. E.g.
+
+terraform import argocd_application_set_yaml.myappset myappset:argocd
@@ -0,0 +1,36 @@
+resource "argocd_application_set_yaml" "guestbook" {
+  manifest = <<EOF
+apiVersion: argoproj.io/v1alpha1
+kind: ApplicationSet
+metadata:
+  name: guestbook
+  namespace: argocd
+spec:
+  goTemplate: true
+  generators:
+    - matrix:
+        generators:
+          - clusters: {}
+          - list:
+              elements:
+                - env: dev
+                - env: prod
+  template:
+    metadata:
+      name: 'guestbook-{{.name}}-{{.env}}'
+    spec:
+      project: default
+      source:
+        repoURL: https://github.com/argoproj/argocd-example-apps.git
+        targetRevision: HEAD
+        path: guestbook
+      destination:
+        server: '{{.server}}'
+        namespace: 'guestbook-{{.env}}'
+EOF
+}
+
+# Manifests can also be loaded from existing files
+resource "argocd_application_set_yaml" "from_file" {
+  manifest = file("${path.module}/appset.yaml")
+}
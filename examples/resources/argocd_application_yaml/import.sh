@@ -0,0 +1,3 @@
+# ArgoCD applications can be imported using an id consisting of `{name}:{namespace}`. E.g.
+
+terraform import argocd_application_yaml.myapp myapp:argocd
@@ -0,0 +1,23 @@
+resource "argocd_application_yaml" "guestbook" {
+  manifest = <<EOF
+apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: guestbook
+  namespace: argocd
+spec:
+  project: default
+  source:
+    repoURL: https://github.com/argoproj/argocd-example-apps.git
+    targetRevision: HEAD
+    path: guestbook
+  destination:
+    server: https://kubernetes.default.svc
+    namespace: guestbook
+EOF
+}
+
+# Manifests can also be loaded from existing files
+resource "argocd_application_yaml" "from_file" {
+  manifest = file("${path.module}/app.yaml")
+}
@@ -2,6 +2,9 @@
 resource "argocd_cluster" "kubernetes" {
   server = "https://1.2.3.4:12345"
 
+  # Take rotated credentials (and their permissions) into account immediately
+  invalidate_cache = true
+
   config {
     bearer_token = "eyJhbGciOiJSUzI..."
 
@@ -81,6 +84,25 @@ resource "argocd_cluster" "gke" {
   }
 }
 
+## GCP GKE cluster, authenticated through Workload Identity
+resource "argocd_cluster" "gke_workload_identity" {
+  server = format("https://%s", data.google_container_cluster.cluster.endpoint)
+  name   = "gke-workload-identity"
+
+  config {
+    exec_provider_config {
+      api_version  = "client.authentication.k8s.io/v1beta1"
+      command      = "argocd-k8s-auth"
+      args         = ["gcp"]
+      install_hint = "argocd-k8s-auth is shipped with the ArgoCD images"
+    }
+
+    tls_client_config {
+      ca_data = base64decode(data.google_container_cluster.cluster.master_auth.0.cluster_ca_certificate)
+    }
+  }
+}
+
 ## AWS EKS cluster
 data "aws_eks_cluster" "cluster" {
   name = "cluster"
@@ -91,6 +113,13 @@ resource "argocd_cluster" "eks" {
   name       = "eks"
   namespaces = ["default", "optional"]
 
+  # Labels can be matched by the cluster generator of application sets
+  metadata {
+    labels = {
+      environment = "production"
+    }
+  }
+
   config {
     aws_auth_config {
       cluster_name = "myekscluster"
@@ -101,3 +130,41 @@ resource "argocd_cluster" "eks" {
     }
   }
 }
+
+## AWS EKS cluster, authenticated through a named AWS profile
+resource "argocd_cluster" "eks_profile" {
+  server = format("https://%s", data.aws_eks_cluster.cluster.endpoint)
+  name   = "eks-profile"
+
+  config {
+    aws_auth_config {
+      cluster_name = "myekscluster"
+      profile      = "argocd"
+    }
+    tls_client_config {
+      ca_data = base64decode(data.aws_eks_cluster.cluster.certificate_authority[0].data)
+    }
+  }
+}
+
+## Cluster configuration extracted from a kubeconfig
+resource "argocd_cluster" "from_kubeconfig" {
+  name       = "staging"
+  kubeconfig = file("path/to/kubeconfig")
+  context    = "staging"
+}
+
+## In-cluster entry, connected to through the service account of ArgoCD
+resource "argocd_cluster" "in_cluster" {
+  server            = "https://kubernetes.default.svc"
+  name              = "management"
+  namespaces        = ["argocd", "monitoring"]
+  cluster_resources = true
+  shard             = "0"
+
+  metadata {
+    labels = {
+      environment = "management"
+    }
+  }
+}
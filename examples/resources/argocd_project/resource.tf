@@ -114,3 +114,12 @@ resource "argocd_project" "myproject" {
     ]
   }
 }
+
+resource "argocd_project" "from_manifest" {
+  metadata {
+    name      = "myproject"
+    namespace = "argocd"
+  }
+
+  manifest = file("${path.module}/myproject.yaml")
+}
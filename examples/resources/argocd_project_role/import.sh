@@ -0,0 +1,4 @@
+# Project roles can be imported using the project name and the role name, separated by a colon.
+
+# Example:
+terraform import argocd_project_role.ci myproject:ci
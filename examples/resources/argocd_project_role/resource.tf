@@ -0,0 +1,12 @@
+resource "argocd_project_role" "ci" {
+  project     = "myproject"
+  name        = "ci"
+  description = "Role used by the CI pipelines of team foo"
+
+  policies = [
+    "p, proj:myproject:ci, applications, get, myproject/*, allow",
+    "p, proj:myproject:ci, applications, sync, myproject/*, allow",
+  ]
+
+  groups = ["team-foo"]
+}
@@ -0,0 +1,27 @@
+resource "argocd_project" "myproject" {
+  metadata {
+    name      = "myproject"
+    namespace = "argocd"
+  }
+
+  manage_sync_windows = false
+
+  spec {
+    source_repos = ["*"]
+
+    destination {
+      server    = "https://kubernetes.default.svc"
+      namespace = "*"
+    }
+  }
+}
+
+resource "argocd_project_sync_window" "nightly" {
+  project      = argocd_project.myproject.metadata[0].name
+  kind         = "deny"
+  schedule     = "0 22 * * *"
+  duration     = "8h"
+  applications = ["*"]
+  manual_sync  = true
+  timezone     = "Europe/London"
+}
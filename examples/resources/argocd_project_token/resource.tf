@@ -5,3 +5,18 @@ resource "argocd_project_token" "secret" {
   expires_in   = "1h"
   renew_before = "30m"
 }
+
+resource "argocd_project_token" "ci" {
+  project = argocd_project_role.ci.project
+  role    = argocd_project_role.ci.name
+
+  # Identify the token in audit logs and `argocd proj role list-tokens`
+  token_id    = "ci-pipeline"
+  description = "token used by the CI pipeline"
+
+  # Regenerate the token whenever the permissions of the role change
+  keepers = {
+    policies = join(";", argocd_project_role.ci.policies)
+    groups   = join(";", argocd_project_role.ci.groups)
+  }
+}
@@ -15,6 +15,11 @@ const (
 	ManagedNamespaceMetadata
 	ApplicationSetApplicationsSyncPolicy
 	ApplicationSetIgnoreApplicationDifferences
+	ApplicationSetPluginGenerator
+	ApplicationSetTemplatePatch
+	ApplicationSetGoTemplateOptions
+	ApplicationSetAnyNamespace
+	ProjectScopedClusters
 )
 
 type FeatureConstraint struct {
@@ -31,4 +36,9 @@ var ConstraintsMap = map[Feature]FeatureConstraint{
 	ManagedNamespaceMetadata:                   {"managed namespace metadsata", semver.MustParse("2.6.0")},
 	ApplicationSetApplicationsSyncPolicy:       {"application set level application sync policy", semver.MustParse("2.8.0")},
 	ApplicationSetIgnoreApplicationDifferences: {"application set ignore application differences", semver.MustParse("2.9.0")},
+	ApplicationSetPluginGenerator:              {"application set plugin generator (`plugin`)", semver.MustParse("2.8.0")},
+	ApplicationSetTemplatePatch:                {"application set template patch (`template_patch`)", semver.MustParse("2.10.0")},
+	ApplicationSetGoTemplateOptions:            {"application set go template options (`go_template_options`)", semver.MustParse("2.7.0")},
+	ApplicationSetAnyNamespace:                 {"application sets in any namespace (`metadata.namespace`)", semver.MustParse("2.8.0")},
+	ProjectScopedClusters:                      {"project-scoped clusters (`project`)", semver.MustParse("2.4.0")},
 }
@@ -96,7 +96,7 @@ func readApplication(ctx context.Context, si *ServerInterface, data *application
 	appName := ids[0]
 	namespace := ids[1]
 
-	apps, err := si.ApplicationClient.List(ctx, &application.ApplicationQuery{
+	app, err := si.ApplicationClient.Get(ctx, &application.ApplicationQuery{
 		Name:         &appName,
 		AppNamespace: &namespace,
 	})
@@ -111,21 +111,6 @@ func readApplication(ctx context.Context, si *ServerInterface, data *application
 		return diags
 	}
 
-	l := len(apps.Items)
-
-	switch {
-	case l < 1:
-		data.ID = types.StringUnknown()
-		return diags
-	case l == 1:
-		break
-	case l > 1:
-		diags.AddError(fmt.Sprintf("found multiple applications matching name '%s' and namespace '%s'", appName, namespace), "")
-		return diags
-	}
-
-	app := apps.Items[0]
-
 	data.Metadata = newObjectMeta(app.ObjectMeta)
 	data.Spec = newApplicationSpec(app.Spec)
 	data.Status = newApplicationStatus(app.Status)
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dcoppa/argo-cd/v2/pkg/apiclient/applicationset"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oboukili/terraform-provider-argocd/internal/diagnostics"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &applicationSetDataSource{}
+
+func NewArgoCDApplicationSetDataSource() datasource.DataSource {
+	return &applicationSetDataSource{}
+}
+
+// applicationSetDataSource defines the data source implementation.
+type applicationSetDataSource struct {
+	si *ServerInterface
+}
+
+func (d *applicationSetDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_set"
+}
+
+func (d *applicationSetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads an existing ArgoCD [application set](https://argo-cd.readthedocs.io/en/stable/operator-manual/applicationset/), e.g. one managed outside of Terraform.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ArgoCD application set identifier",
+				Computed:            true,
+			},
+			"metadata": objectMetaSchemaAttribute("applicationsets.argoproj.io", true),
+			"manifest": schema.StringAttribute{
+				MarkdownDescription: "YAML manifest of the application set, including its spec (generators, template, sync policy, etc.).",
+				Computed:            true,
+			},
+			"status": applicationSetStatusSchemaAttribute(),
+		},
+	}
+}
+
+func (d *applicationSetDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *applicationSetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data applicationSetModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Metadata.Name.ValueString()
+
+	as, err := d.si.ApplicationSetClient.Get(ctx, &applicationset.ApplicationSetGetQuery{
+		Name:            name,
+		AppsetNamespace: data.Metadata.Namespace.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "application set", name, err)...)
+		return
+	}
+
+	manifest, err := flattenApplicationSetManifest(as, "")
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to flatten application set %s", name), err)...)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", as.Name, as.Namespace))
+	data.Metadata = newObjectMeta(as.ObjectMeta)
+	data.Manifest = types.StringValue(manifest)
+	data.Status = newApplicationSetStatus(as.Status)
+
+	tflog.Trace(ctx, "read ArgoCD application set")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
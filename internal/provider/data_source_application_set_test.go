@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDApplicationSetDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"argocd": {
+						VersionConstraint: "~> 5.0",
+						Source:            "oboukili/argocd",
+					},
+				},
+				Config: `
+resource "argocd_application_set" "foo" {
+	metadata {
+		name = "appset-data-source"
+		labels = {
+			acceptance = "true"
+		}
+	}
+
+	spec {
+		generator {
+			list {
+				elements = [
+					{
+						cluster = "in-cluster"
+						url     = "https://kubernetes.default.svc"
+					}
+				]
+			}
+		}
+
+		template {
+			metadata {
+				name = "appset-data-source-{{cluster}}"
+			}
+
+			spec {
+				project = "default"
+
+				source {
+					repo_url        = "https://github.com/argoproj/argocd-example-apps.git"
+					target_revision = "HEAD"
+					path            = "guestbook"
+				}
+
+				destination {
+					server    = "{{url}}"
+					namespace = "default"
+				}
+			}
+		}
+	}
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("argocd_application_set.foo", "metadata.0.uid"),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Config: `
+data "argocd_application_set" "foo" {
+	metadata = {
+		name = "appset-data-source"
+	}
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_application_set.foo", "id", "appset-data-source:argocd"),
+					resource.TestCheckResourceAttrSet("data.argocd_application_set.foo", "metadata.uid"),
+					resource.TestCheckResourceAttr("data.argocd_application_set.foo", "metadata.name", "appset-data-source"),
+					resource.TestCheckResourceAttr("data.argocd_application_set.foo", "metadata.namespace", "argocd"),
+					resource.TestCheckResourceAttr("data.argocd_application_set.foo", "metadata.labels.acceptance", "true"),
+					resource.TestMatchResourceAttr("data.argocd_application_set.foo", "manifest", regexp.MustCompile(`name: appset-data-source-\{\{cluster\}\}`)),
+					resource.TestMatchResourceAttr("data.argocd_application_set.foo", "manifest", regexp.MustCompile(`url: https://kubernetes.default.svc`)),
+					resource.TestCheckResourceAttrSet("data.argocd_application_set.foo", "status.conditions.#"),
+				),
+			},
+		},
+	})
+}
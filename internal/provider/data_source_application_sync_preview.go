@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dcoppa/argo-cd/v2/pkg/apiclient/application"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oboukili/terraform-provider-argocd/internal/diagnostics"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &applicationSyncPreviewDataSource{}
+
+func NewArgoCDApplicationSyncPreviewDataSource() datasource.DataSource {
+	return &applicationSyncPreviewDataSource{}
+}
+
+// applicationSyncPreviewDataSource defines the data source implementation.
+type applicationSyncPreviewDataSource struct {
+	si *ServerInterface
+}
+
+func (d *applicationSyncPreviewDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_sync_preview"
+}
+
+func (d *applicationSyncPreviewDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Previews the changes a sync of an existing ArgoCD application would apply, by comparing the live state of its resources with the target state computed by ArgoCD (i.e. the application target revision, unless `revision` is set). Useful to gate deployments on the expected changes, e.g. in CI.",
+		Attributes:          applicationSyncPreviewSchemaAttributes(),
+	}
+}
+
+func (d *applicationSyncPreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *applicationSyncPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data applicationSyncPreviewModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appName := data.Name.ValueString()
+	query := &application.ApplicationQuery{
+		Name: &appName,
+	}
+
+	if !data.Namespace.IsNull() {
+		namespace := data.Namespace.ValueString()
+		query.AppNamespace = &namespace
+	}
+
+	// Resolve the application namespace, as ArgoCD defaults to its own
+	// namespace when none is provided
+	app, err := d.si.ApplicationClient.Get(ctx, query)
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "application", appName, err)...)
+		return
+	}
+
+	namespace := app.Namespace
+
+	diffs, err := d.si.ApplicationClient.ManagedResources(ctx, &application.ResourcesQuery{
+		ApplicationName: &appName,
+		AppNamespace:    &namespace,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("diff", "application", appName, err)...)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", appName, namespace))
+	data.Namespace = types.StringValue(namespace)
+
+	if data.Revision.IsNull() {
+		data.Resources = newApplicationSyncPreviewResources(diffs.Items)
+	} else {
+		if app.Spec.HasMultipleSources() {
+			resp.Diagnostics.AddError("revision is only supported for applications with a single source", fmt.Sprintf("application %s has multiple sources", appName))
+			return
+		}
+
+		revision := data.Revision.ValueString()
+
+		manifests, err := d.si.ApplicationClient.GetManifests(ctx, &application.ApplicationManifestQuery{
+			Name:         &appName,
+			AppNamespace: &namespace,
+			Revision:     &revision,
+		})
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("get manifests of", "application", appName, err)...)
+			return
+		}
+
+		data.Resources, err = newApplicationSyncPreviewResourcesAtRevision(diffs.Items, manifests.Manifests, app.Spec.Destination.Namespace, app.Spec.IgnoreDifferences)
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to preview sync of application %s to revision %s", appName, revision), err)...)
+			return
+		}
+	}
+
+	data.HasChanges = types.BoolValue(len(data.Resources) > 0)
+
+	tflog.Trace(ctx, "read ArgoCD application sync preview")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
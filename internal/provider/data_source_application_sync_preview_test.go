@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDApplicationSyncPreviewDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The application is never synced, hence all its resources
+				// are expected to be created
+				Config: fmt.Sprintf(`
+%s
+
+data "argocd_application_sync_preview" "this" {
+  name = "%s"
+
+  depends_on = [argocd_application_yaml.this]
+}
+				`, testAccArgoCDApplicationYAMLResource(name, "guestbook"), name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_application_sync_preview.this", "id", name+":argocd"),
+					resource.TestCheckResourceAttr("data.argocd_application_sync_preview.this", "namespace", "argocd"),
+					resource.TestCheckResourceAttr("data.argocd_application_sync_preview.this", "has_changes", "true"),
+					resource.TestCheckResourceAttr("data.argocd_application_sync_preview.this", "resources.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.argocd_application_sync_preview.this", "resources.*", map[string]string{
+						"kind":   "Deployment",
+						"name":   "guestbook-ui",
+						"action": "create",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.argocd_application_sync_preview.this", "resources.*", map[string]string{
+						"kind":   "Service",
+						"name":   "guestbook-ui",
+						"action": "create",
+					}),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "argocd_application_sync_preview" "this" {
+  name     = "%s"
+  revision = "master"
+
+  depends_on = [argocd_application_yaml.this]
+}
+				`, testAccArgoCDApplicationYAMLResource(name, "guestbook"), name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_application_sync_preview.this", "revision", "master"),
+					resource.TestCheckResourceAttr("data.argocd_application_sync_preview.this", "has_changes", "true"),
+					resource.TestCheckResourceAttr("data.argocd_application_sync_preview.this", "resources.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.argocd_application_sync_preview.this", "resources.*", map[string]string{
+						"kind":   "Deployment",
+						"name":   "guestbook-ui",
+						"action": "create",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDClusterDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "argocd_cluster" "by_server" {
+	server = "https://kubernetes.default.svc"
+}
+
+data "argocd_cluster" "by_name" {
+	name = "in-cluster"
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_cluster.by_server", "id", "https://kubernetes.default.svc"),
+					resource.TestCheckResourceAttr("data.argocd_cluster.by_server", "name", "in-cluster"),
+					resource.TestCheckResourceAttrSet("data.argocd_cluster.by_server", "info.connection_state.status"),
+					resource.TestCheckResourceAttrPair("data.argocd_cluster.by_name", "server", "data.argocd_cluster.by_server", "server"),
+				),
+			},
+			{
+				Config: `
+data "argocd_cluster" "invalid" {
+	server = "https://kubernetes.default.svc"
+	name   = "in-cluster"
+}
+				`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
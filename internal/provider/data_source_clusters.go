@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/dcoppa/argo-cd/v2/pkg/apiclient/cluster"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oboukili/terraform-provider-argocd/internal/diagnostics"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &clustersDataSource{}
+
+func NewArgoCDClustersDataSource() datasource.DataSource {
+	return &clustersDataSource{}
+}
+
+// clustersDataSource defines the data source implementation.
+type clustersDataSource struct {
+	si *ServerInterface
+}
+
+func (d *clustersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clusters"
+}
+
+func (d *clustersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the clusters registered within ArgoCD, optionally filtered by name prefix, labels and project, e.g. to iterate over a fleet of clusters with `for_each`.",
+		Attributes:          clustersSchemaAttributes(),
+	}
+}
+
+func (d *clustersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *clustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data clustersModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := d.si.ClusterClient.List(ctx, &cluster.ClusterQuery{})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error("failed to list clusters", err)...)
+		return
+	}
+
+	clusters := make([]clustersItem, 0, len(cl.Items))
+
+	for _, c := range cl.Items {
+		if data.matches(c) {
+			clusters = append(clusters, newClustersItem(c))
+		}
+	}
+
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return clusters[i].Server.ValueString() < clusters[j].Server.ValueString()
+	})
+
+	data.ID = types.StringValue("clusters")
+	data.Clusters = clusters
+
+	tflog.Trace(ctx, "read ArgoCD clusters")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDClustersDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "argocd_clusters" "all" {}
+
+data "argocd_clusters" "in_cluster" {
+	name_prefix = "in-"
+}
+
+data "argocd_clusters" "none" {
+	labels = {
+		"non-existent" = "label"
+	}
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.argocd_clusters.all", "clusters.#"),
+					resource.TestCheckResourceAttr("data.argocd_clusters.in_cluster", "clusters.#", "1"),
+					resource.TestCheckResourceAttr("data.argocd_clusters.in_cluster", "clusters.0.server", "https://kubernetes.default.svc"),
+					resource.TestCheckResourceAttr("data.argocd_clusters.none", "clusters.#", "0"),
+				),
+			},
+		},
+	})
+}
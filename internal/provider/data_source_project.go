@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dcoppa/argo-cd/v2/pkg/apiclient/project"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oboukili/terraform-provider-argocd/internal/diagnostics"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &projectDataSource{}
+
+func NewArgoCDProjectDataSource() datasource.DataSource {
+	return &projectDataSource{}
+}
+
+// projectDataSource defines the data source implementation.
+type projectDataSource struct {
+	si *ServerInterface
+}
+
+func (d *projectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (d *projectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads an existing ArgoCD [project](https://argo-cd.readthedocs.io/en/stable/user-guide/projects/), including its roles (and the metadata of the JWT tokens issued for them) and sync windows, e.g. to build on a project managed by another Terraform workspace.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ArgoCD project identifier",
+				Computed:            true,
+			},
+			"metadata": objectMetaSchemaAttribute("appprojects.argoproj.io", true),
+			"spec":     projectSpecSchemaAttribute(),
+		},
+	}
+}
+
+func (d *projectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data projectModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Metadata.Name.ValueString()
+
+	p, err := d.si.ProjectClient.Get(ctx, &project.ProjectQuery{
+		Name: name,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "project", name, err)...)
+		return
+	}
+
+	data.ID = types.StringValue(p.Name)
+	data.Metadata = newObjectMeta(p.ObjectMeta)
+	data.Spec = newProjectSpec(*p)
+
+	tflog.Trace(ctx, "read ArgoCD project")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
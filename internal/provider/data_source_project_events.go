@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dcoppa/argo-cd/v2/pkg/apiclient/application"
+	"github.com/dcoppa/argo-cd/v2/pkg/apiclient/project"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oboukili/terraform-provider-argocd/internal/diagnostics"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &projectEventsDataSource{}
+
+func NewArgoCDProjectEventsDataSource() datasource.DataSource {
+	return &projectEventsDataSource{}
+}
+
+// projectEventsDataSource defines the data source implementation.
+type projectEventsDataSource struct {
+	si *ServerInterface
+}
+
+func (d *projectEventsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_events"
+}
+
+func (d *projectEventsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the recent Kubernetes events of an ArgoCD [project](https://argo-cd.readthedocs.io/en/stable/user-guide/projects/) and, optionally, of its applications, e.g. to surface why a change was rejected by ArgoCD.",
+		Attributes:          projectEventsSchemaAttributes(),
+	}
+}
+
+func (d *projectEventsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.si = si
+}
+
+func (d *projectEventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data projectEventsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(d.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Project.ValueString()
+
+	el, err := d.si.ProjectClient.ListEvents(ctx, &project.ProjectQuery{
+		Name: name,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "events of project", name, err)...)
+		return
+	}
+
+	events := append([]corev1.Event{}, el.Items...)
+
+	if data.IncludeApplications.ValueBool() {
+		apps, err := d.si.ApplicationClient.List(ctx, &application.ApplicationQuery{
+			Projects: []string{name},
+		})
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "applications of project", name, err)...)
+			return
+		}
+
+		for _, a := range apps.Items {
+			a := a
+
+			ael, err := d.si.ApplicationClient.ListResourceEvents(ctx, &application.ApplicationResourceEventsQuery{
+				Name:         &a.Name,
+				AppNamespace: &a.Namespace,
+				Project:      &name,
+			})
+			if err != nil {
+				resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "events of application", a.Name, err)...)
+				return
+			}
+
+			events = append(events, ael.Items...)
+		}
+	}
+
+	data.ID = types.StringValue(name)
+	data.Events = newProjectEvents(events)
+
+	tflog.Trace(ctx, "read ArgoCD project events")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDProjectDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "argocd_project" "foo" {
+	metadata = {
+		name = "myproject2"
+	}
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.argocd_project.foo", "id", "myproject2"),
+					resource.TestCheckResourceAttrSet("data.argocd_project.foo", "metadata.uid"),
+					resource.TestCheckResourceAttr("data.argocd_project.foo", "metadata.namespace", "argocd"),
+					resource.TestCheckResourceAttr("data.argocd_project.foo", "spec.description", "myproject2"),
+					resource.TestCheckResourceAttr("data.argocd_project.foo", "spec.source_repos.0", "*"),
+					resource.TestCheckResourceAttr("data.argocd_project.foo", "spec.destination.0.server", "https://kubernetes.default.svc"),
+					resource.TestCheckResourceAttr("data.argocd_project.foo", "spec.cluster_resource_whitelist.0.group", "*"),
+					resource.TestCheckResourceAttr("data.argocd_project.foo", "spec.orphaned_resources.warn", "false"),
+					resource.TestCheckResourceAttr("data.argocd_project.foo", "spec.role.0.name", "test-role1234"),
+					resource.TestCheckResourceAttr("data.argocd_project.foo", "spec.role.0.policies.#", "4"),
+					resource.TestCheckResourceAttrSet("data.argocd_project.foo", "spec.role.0.jwt_tokens.#"),
+				),
+			},
+		},
+	})
+}
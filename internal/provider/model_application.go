@@ -116,12 +116,13 @@ func newApplicationDestination(ad v1alpha1.ApplicationDestination) applicationDe
 }
 
 type applicationResourceIgnoreDifferences struct {
-	Group             types.String   `tfsdk:"group"`
-	Kind              types.String   `tfsdk:"kind"`
-	Name              types.String   `tfsdk:"name"`
-	Namespace         types.String   `tfsdk:"namespace"`
-	JsonPointers      []types.String `tfsdk:"json_pointers"`
-	JQPathExpressions []types.String `tfsdk:"jq_path_expressions"`
+	Group                 types.String   `tfsdk:"group"`
+	Kind                  types.String   `tfsdk:"kind"`
+	Name                  types.String   `tfsdk:"name"`
+	Namespace             types.String   `tfsdk:"namespace"`
+	JsonPointers          []types.String `tfsdk:"json_pointers"`
+	JQPathExpressions     []types.String `tfsdk:"jq_path_expressions"`
+	ManagedFieldsManagers []types.String `tfsdk:"managed_fields_managers"`
 }
 
 func applicationResourceIgnoreDifferencesSchemaAttribute(computed bool) schema.Attribute {
@@ -163,6 +164,12 @@ func applicationResourceIgnoreDifferencesSchemaAttribute(computed bool) schema.A
 					Optional:            !computed,
 					ElementType:         types.StringType,
 				},
+				"managed_fields_managers": schema.SetAttribute{
+					MarkdownDescription: "List of external controller manager names whose changes to fields should be ignored.",
+					Computed:            computed,
+					Optional:            !computed,
+					ElementType:         types.StringType,
+				},
 			},
 		},
 	}
@@ -176,12 +183,13 @@ func newApplicationResourceIgnoreDifferences(diffs []v1alpha1.ResourceIgnoreDiff
 	ds := make([]applicationResourceIgnoreDifferences, len(diffs))
 	for i, v := range diffs {
 		ds[i] = applicationResourceIgnoreDifferences{
-			Group:             types.StringValue(v.Group),
-			Kind:              types.StringValue(v.Kind),
-			Name:              types.StringValue(v.Name),
-			Namespace:         types.StringValue(v.Namespace),
-			JsonPointers:      pie.Map(v.JSONPointers, types.StringValue),
-			JQPathExpressions: pie.Map(v.JQPathExpressions, types.StringValue),
+			Group:                 types.StringValue(v.Group),
+			Kind:                  types.StringValue(v.Kind),
+			Name:                  types.StringValue(v.Name),
+			Namespace:             types.StringValue(v.Namespace),
+			JsonPointers:          pie.Map(v.JSONPointers, types.StringValue),
+			JQPathExpressions:     pie.Map(v.JQPathExpressions, types.StringValue),
+			ManagedFieldsManagers: pie.Map(v.ManagedFieldsManagers, types.StringValue),
 		}
 	}
 
@@ -618,12 +626,19 @@ func newApplicationSourceHelmParameters(hps []v1alpha1.HelmParameter) []applicat
 }
 
 type applicationSourceKustomize struct {
-	CommonAnnotations map[string]types.String `tfsdk:"common_annotations"`
-	CommonLabels      map[string]types.String `tfsdk:"common_labels"`
-	Images            []types.String          `tfsdk:"images"`
-	NamePrefix        types.String            `tfsdk:"name_prefix"`
-	NameSuffix        types.String            `tfsdk:"name_suffix"`
-	Version           types.String            `tfsdk:"version"`
+	CommonAnnotations      map[string]types.String       `tfsdk:"common_annotations"`
+	CommonAnnotationsForce types.Bool                    `tfsdk:"common_annotations_force"`
+	CommonLabels           map[string]types.String       `tfsdk:"common_labels"`
+	CommonLabelsForce      types.Bool                    `tfsdk:"common_labels_force"`
+	Components             []types.String                `tfsdk:"components"`
+	Images                 []types.String                `tfsdk:"images"`
+	LabelWithoutSelector   types.Bool                    `tfsdk:"label_without_selector"`
+	NamePrefix             types.String                  `tfsdk:"name_prefix"`
+	NameSuffix             types.String                  `tfsdk:"name_suffix"`
+	Namespace              types.String                  `tfsdk:"namespace"`
+	Patches                []applicationKustomizePatch   `tfsdk:"patches"`
+	Replicas               []applicationKustomizeReplica `tfsdk:"replicas"`
+	Version                types.String                  `tfsdk:"version"`
 }
 
 func applicationSourceKustomizeSchemaAttribute(computed bool) schema.Attribute {
@@ -671,6 +686,34 @@ func applicationSourceKustomizeSchemaAttribute(computed bool) schema.Attribute {
 					validators.MetadataAnnotations(),
 				},
 			},
+			"common_labels_force": schema.BoolAttribute{
+				MarkdownDescription: "Whether to force applying `common_labels` to resources, overriding existing labels with the same keys.",
+				Computed:            computed,
+				Optional:            !computed,
+			},
+			"common_annotations_force": schema.BoolAttribute{
+				MarkdownDescription: "Whether to force applying `common_annotations` to resources, overriding existing annotations with the same keys.",
+				Computed:            computed,
+				Optional:            !computed,
+			},
+			"label_without_selector": schema.BoolAttribute{
+				MarkdownDescription: "Whether to apply `common_labels` to resource templates and selectors. If `true`, labels are only applied to resource metadata.",
+				Computed:            computed,
+				Optional:            !computed,
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace that overrides the namespace set in the kustomization.",
+				Computed:            computed,
+				Optional:            !computed,
+			},
+			"components": schema.ListAttribute{
+				MarkdownDescription: "List of relative paths to Kustomize components added to the kustomization before building.",
+				Computed:            computed,
+				Optional:            !computed,
+				ElementType:         types.StringType,
+			},
+			"patches":  applicationKustomizePatchesSchemaAttribute(computed),
+			"replicas": applicationKustomizeReplicasSchemaAttribute(computed),
 		},
 	}
 }
@@ -681,11 +724,18 @@ func newApplicationSourceKustomize(ask *v1alpha1.ApplicationSourceKustomize) *ap
 	}
 
 	k := &applicationSourceKustomize{
-		CommonAnnotations: utils.MapMap(ask.CommonAnnotations, types.StringValue),
-		CommonLabels:      utils.MapMap(ask.CommonLabels, types.StringValue),
-		NamePrefix:        types.StringValue(ask.NamePrefix),
-		NameSuffix:        types.StringValue(ask.NameSuffix),
-		Version:           types.StringValue(ask.Version),
+		CommonAnnotations:      utils.MapMap(ask.CommonAnnotations, types.StringValue),
+		CommonAnnotationsForce: types.BoolValue(ask.ForceCommonAnnotations),
+		CommonLabels:           utils.MapMap(ask.CommonLabels, types.StringValue),
+		CommonLabelsForce:      types.BoolValue(ask.ForceCommonLabels),
+		Components:             pie.Map(ask.Components, types.StringValue),
+		LabelWithoutSelector:   types.BoolValue(ask.LabelWithoutSelector),
+		NamePrefix:             types.StringValue(ask.NamePrefix),
+		NameSuffix:             types.StringValue(ask.NameSuffix),
+		Namespace:              types.StringValue(ask.Namespace),
+		Patches:                newApplicationKustomizePatches(ask.Patches),
+		Replicas:               newApplicationKustomizeReplicas(ask.Replicas),
+		Version:                types.StringValue(ask.Version),
 	}
 
 	if ask.Images != nil {
@@ -698,6 +748,167 @@ func newApplicationSourceKustomize(ask *v1alpha1.ApplicationSourceKustomize) *ap
 	return k
 }
 
+type applicationKustomizePatch struct {
+	Options map[string]types.Bool       `tfsdk:"options"`
+	Patch   types.String                `tfsdk:"patch"`
+	Path    types.String                `tfsdk:"path"`
+	Target  *applicationKustomizeTarget `tfsdk:"target"`
+}
+
+type applicationKustomizeTarget struct {
+	AnnotationSelector types.String `tfsdk:"annotation_selector"`
+	Group              types.String `tfsdk:"group"`
+	Kind               types.String `tfsdk:"kind"`
+	LabelSelector      types.String `tfsdk:"label_selector"`
+	Name               types.String `tfsdk:"name"`
+	Namespace          types.String `tfsdk:"namespace"`
+	Version            types.String `tfsdk:"version"`
+}
+
+func applicationKustomizePatchesSchemaAttribute(computed bool) schema.Attribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "List of Kustomize patches to apply.",
+		Computed:            computed,
+		Optional:            !computed,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"patch": schema.StringAttribute{
+					MarkdownDescription: "Inline Kustomize patch to apply.",
+					Computed:            computed,
+					Optional:            !computed,
+				},
+				"path": schema.StringAttribute{
+					MarkdownDescription: "File path to a patch to apply, relative to the kustomization.",
+					Computed:            computed,
+					Optional:            !computed,
+				},
+				"options": schema.MapAttribute{
+					MarkdownDescription: "Additional [options](https://kubectl.docs.kubernetes.io/references/kustomize/kustomization/patches/#name-and-kind-changes).",
+					Computed:            computed,
+					Optional:            !computed,
+					ElementType:         types.BoolType,
+				},
+				"target": schema.SingleNestedAttribute{
+					MarkdownDescription: "Target(s) to patch.",
+					Computed:            computed,
+					Optional:            !computed,
+					Attributes: map[string]schema.Attribute{
+						"group": schema.StringAttribute{
+							MarkdownDescription: "The Kubernetes resource Group to match for.",
+							Computed:            computed,
+							Optional:            !computed,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "The Kubernetes resource Version to match for.",
+							Computed:            computed,
+							Optional:            !computed,
+						},
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "The Kubernetes resource Kind to match for.",
+							Computed:            computed,
+							Optional:            !computed,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The Kubernetes resource Name to match for.",
+							Computed:            computed,
+							Optional:            !computed,
+						},
+						"namespace": schema.StringAttribute{
+							MarkdownDescription: "The Kubernetes resource Namespace to match for.",
+							Computed:            computed,
+							Optional:            !computed,
+						},
+						"label_selector": schema.StringAttribute{
+							MarkdownDescription: "Label selector to use when matching the Kubernetes resource.",
+							Computed:            computed,
+							Optional:            !computed,
+						},
+						"annotation_selector": schema.StringAttribute{
+							MarkdownDescription: "Annotation selector to use when matching the Kubernetes resource.",
+							Computed:            computed,
+							Optional:            !computed,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newApplicationKustomizePatches(kps v1alpha1.KustomizePatches) []applicationKustomizePatch {
+	if kps == nil {
+		return nil
+	}
+
+	ps := make([]applicationKustomizePatch, len(kps))
+
+	for i, v := range kps {
+		ps[i] = applicationKustomizePatch{
+			Options: utils.MapMap(v.Options, types.BoolValue),
+			Patch:   types.StringValue(v.Patch),
+			Path:    types.StringValue(v.Path),
+		}
+
+		if v.Target != nil {
+			ps[i].Target = &applicationKustomizeTarget{
+				AnnotationSelector: types.StringValue(v.Target.AnnotationSelector),
+				Group:              types.StringValue(v.Target.Group),
+				Kind:               types.StringValue(v.Target.Kind),
+				LabelSelector:      types.StringValue(v.Target.LabelSelector),
+				Name:               types.StringValue(v.Target.Name),
+				Namespace:          types.StringValue(v.Target.Namespace),
+				Version:            types.StringValue(v.Target.Version),
+			}
+		}
+	}
+
+	return ps
+}
+
+type applicationKustomizeReplica struct {
+	Count types.String `tfsdk:"count"`
+	Name  types.String `tfsdk:"name"`
+}
+
+func applicationKustomizeReplicasSchemaAttribute(computed bool) schema.Attribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "List of Kustomize replica count overrides.",
+		Computed:            computed,
+		Optional:            !computed,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Name of the resource whose replica count is overridden.",
+					Computed:            computed,
+					Optional:            !computed,
+				},
+				"count": schema.StringAttribute{
+					MarkdownDescription: "Number of replicas.",
+					Computed:            computed,
+					Optional:            !computed,
+				},
+			},
+		},
+	}
+}
+
+func newApplicationKustomizeReplicas(krs v1alpha1.KustomizeReplicas) []applicationKustomizeReplica {
+	if krs == nil {
+		return nil
+	}
+
+	rs := make([]applicationKustomizeReplica, len(krs))
+
+	for i, v := range krs {
+		rs[i] = applicationKustomizeReplica{
+			Count: types.StringValue(v.Count.String()),
+			Name:  types.StringValue(v.Name),
+		}
+	}
+
+	return rs
+}
+
 type applicationSourcePlugin struct {
 	Env        []applicationEnvEntry              `tfsdk:"env"`
 	Name       types.String                       `tfsdk:"name"`
@@ -1121,11 +1332,13 @@ func newApplicationHealthStatus(hs *v1alpha1.HealthStatus) *applicationHealthSta
 }
 
 type applicationOperationState struct {
-	FinishedAt types.String `tfsdk:"finished_at"`
-	Message    types.String `tfsdk:"message"`
-	Phase      types.String `tfsdk:"phase"`
-	RetryCount types.Int64  `tfsdk:"retry_count"`
-	StartedAt  types.String `tfsdk:"started_at"`
+	FinishedAt types.String   `tfsdk:"finished_at"`
+	Message    types.String   `tfsdk:"message"`
+	Phase      types.String   `tfsdk:"phase"`
+	RetryCount types.Int64    `tfsdk:"retry_count"`
+	Revision   types.String   `tfsdk:"revision"`
+	Revisions  []types.String `tfsdk:"revisions"`
+	StartedAt  types.String   `tfsdk:"started_at"`
 }
 
 func applicationOperationStateSchemaAttribute() schema.Attribute {
@@ -1149,6 +1362,15 @@ func applicationOperationStateSchemaAttribute() schema.Attribute {
 				MarkdownDescription: "Count of operation retries.",
 				Computed:            true,
 			},
+			"revision": schema.StringAttribute{
+				MarkdownDescription: "Revision the last sync operation synced the application to.",
+				Computed:            true,
+			},
+			"revisions": schema.ListAttribute{
+				MarkdownDescription: "Revisions the last sync operation synced the application sources to, for applications with multiple sources.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"started_at": schema.StringAttribute{
 				MarkdownDescription: "Time of operation start.",
 				Computed:            true,
@@ -1162,13 +1384,20 @@ func newApplicationOperationState(os *v1alpha1.OperationState) *applicationOpera
 		return nil
 	}
 
-	return &applicationOperationState{
+	m := &applicationOperationState{
 		FinishedAt: utils.OptionalTimeString(os.FinishedAt),
 		Message:    types.StringValue(os.Message),
 		Phase:      types.StringValue(string(os.Phase)),
 		RetryCount: types.Int64Value(os.RetryCount),
 		StartedAt:  types.StringValue(os.StartedAt.String()),
 	}
+
+	if os.SyncResult != nil {
+		m.Revision = types.StringValue(os.SyncResult.Revision)
+		m.Revisions = pie.Map(os.SyncResult.Revisions, types.StringValue)
+	}
+
+	return m
 }
 
 type applicationResourceStatus struct {
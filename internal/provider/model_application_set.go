@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/oboukili/terraform-provider-argocd/internal/utils"
+)
+
+type applicationSetModel struct {
+	ID       types.String          `tfsdk:"id"`
+	Metadata objectMeta            `tfsdk:"metadata"`
+	Manifest types.String          `tfsdk:"manifest"`
+	Status   *applicationSetStatus `tfsdk:"status"`
+}
+
+type applicationSetStatus struct {
+	ApplicationStatus []applicationSetApplicationStatus `tfsdk:"application_status"`
+	Conditions        []applicationSetCondition         `tfsdk:"conditions"`
+}
+
+func applicationSetStatusSchemaAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Status information for the application set.",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"application_status": applicationSetApplicationStatusSchemaAttribute(),
+			"conditions":         applicationSetConditionSchemaAttribute(),
+		},
+	}
+}
+
+func newApplicationSetStatus(ass v1alpha1.ApplicationSetStatus) *applicationSetStatus {
+	return &applicationSetStatus{
+		ApplicationStatus: newApplicationSetApplicationStatuses(ass.ApplicationStatus),
+		Conditions:        newApplicationSetConditions(ass.Conditions),
+	}
+}
+
+type applicationSetApplicationStatus struct {
+	Application        types.String `tfsdk:"application"`
+	LastTransitionTime types.String `tfsdk:"last_transition_time"`
+	Message            types.String `tfsdk:"message"`
+	Status             types.String `tfsdk:"status"`
+	Step               types.String `tfsdk:"step"`
+}
+
+func applicationSetApplicationStatusSchemaAttribute() schema.Attribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "Progressive sync status of the applications generated by this application set.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"application": schema.StringAttribute{
+					MarkdownDescription: "Name of the application.",
+					Computed:            true,
+				},
+				"last_transition_time": schema.StringAttribute{
+					MarkdownDescription: "The time the status was last updated.",
+					Computed:            true,
+				},
+				"message": schema.StringAttribute{
+					MarkdownDescription: "Human-readable message indicating details about the status.",
+					Computed:            true,
+				},
+				"status": schema.StringAttribute{
+					MarkdownDescription: "Status of the application as perceived by the application set, one of `Waiting`, `Pending`, `Progressing` or `Healthy`.",
+					Computed:            true,
+				},
+				"step": schema.StringAttribute{
+					MarkdownDescription: "Rolling sync step the application is updated in.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func newApplicationSetApplicationStatuses(asas []v1alpha1.ApplicationSetApplicationStatus) []applicationSetApplicationStatus {
+	if asas == nil {
+		return nil
+	}
+
+	ss := make([]applicationSetApplicationStatus, len(asas))
+
+	for i, v := range asas {
+		ss[i] = applicationSetApplicationStatus{
+			Application:        types.StringValue(v.Application),
+			LastTransitionTime: utils.OptionalTimeString(v.LastTransitionTime),
+			Message:            types.StringValue(v.Message),
+			Status:             types.StringValue(v.Status),
+			Step:               types.StringValue(v.Step),
+		}
+	}
+
+	return ss
+}
+
+type applicationSetCondition struct {
+	Message            types.String `tfsdk:"message"`
+	LastTransitionTime types.String `tfsdk:"last_transition_time"`
+	Reason             types.String `tfsdk:"reason"`
+	Status             types.String `tfsdk:"status"`
+	Type               types.String `tfsdk:"type"`
+}
+
+func applicationSetConditionSchemaAttribute() schema.Attribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "List of currently observed application set conditions.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"message": schema.StringAttribute{
+					MarkdownDescription: "Human-readable message indicating details about condition.",
+					Computed:            true,
+				},
+				"last_transition_time": schema.StringAttribute{
+					MarkdownDescription: "The time the condition was last observed.",
+					Computed:            true,
+				},
+				"reason": schema.StringAttribute{
+					MarkdownDescription: "Reason of the condition.",
+					Computed:            true,
+				},
+				"status": schema.StringAttribute{
+					MarkdownDescription: "Status of the condition, one of `True`, `False` or `Unknown`.",
+					Computed:            true,
+				},
+				"type": schema.StringAttribute{
+					MarkdownDescription: "Application set condition type.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func newApplicationSetConditions(ascs []v1alpha1.ApplicationSetCondition) []applicationSetCondition {
+	if ascs == nil {
+		return nil
+	}
+
+	cs := make([]applicationSetCondition, len(ascs))
+
+	for i, v := range ascs {
+		cs[i] = applicationSetCondition{
+			LastTransitionTime: utils.OptionalTimeString(v.LastTransitionTime),
+			Message:            types.StringValue(v.Message),
+			Reason:             types.StringValue(v.Reason),
+			Status:             types.StringValue(string(v.Status)),
+			Type:               types.StringValue(string(v.Type)),
+		}
+	}
+
+	return cs
+}
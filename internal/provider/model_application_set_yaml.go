@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	customtypes "github.com/oboukili/terraform-provider-argocd/internal/types"
+)
+
+type applicationSetYAMLModel struct {
+	ID       types.String         `tfsdk:"id"`
+	Manifest customtypes.Manifest `tfsdk:"manifest"`
+}
+
+func applicationSetYAMLSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ArgoCD application set identifier",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"manifest": schema.StringAttribute{
+			MarkdownDescription: "Full `ApplicationSet` manifest, in YAML or JSON format. Only `metadata.name`, `metadata.namespace`, `metadata.labels`, `metadata.annotations`, `metadata.finalizers` and `spec` are managed, any other field (e.g. `status`) is ignored. `metadata.namespace` defaults to the namespace ArgoCD is running in. Fields defaulted by ArgoCD are not reported as differences. Changing the name or namespace of the application set will force the creation of a new resource.",
+			CustomType:          customtypes.ManifestType,
+			Required:            true,
+		},
+	}
+}
+
+// applicationSetManifest is the subset of an ApplicationSet that is managed
+// through the `manifest` attribute.
+type applicationSetManifest struct {
+	APIVersion string                      `json:"apiVersion"`
+	Kind       string                      `json:"kind"`
+	Metadata   manifestMetadata            `json:"metadata"`
+	Spec       v1alpha1.ApplicationSetSpec `json:"spec"`
+}
+
+func newApplicationSetManifest(as *v1alpha1.ApplicationSet) applicationSetManifest {
+	return applicationSetManifest{
+		APIVersion: manifestAPIVersion,
+		Kind:       "ApplicationSet",
+		Metadata:   newManifestMetadata(as),
+		Spec:       as.Spec,
+	}
+}
+
+// expandApplicationSetManifest parses a YAML or JSON ApplicationSet manifest.
+func expandApplicationSetManifest(manifest string) (*v1alpha1.ApplicationSet, error) {
+	var as v1alpha1.ApplicationSet
+
+	if err := expandManifest(manifest, "ApplicationSet", &as); err != nil {
+		return nil, err
+	}
+
+	return &v1alpha1.ApplicationSet{
+		TypeMeta:   as.TypeMeta,
+		ObjectMeta: managedObjectMeta(&as),
+		Spec:       as.Spec,
+	}, nil
+}
+
+// flattenApplicationSetManifest returns the live application set as a YAML
+// manifest, or the `current` manifest if it matches the live application set,
+// see flattenManifest.
+func flattenApplicationSetManifest(as *v1alpha1.ApplicationSet, current string) (string, error) {
+	var desired interface{}
+
+	if d, err := expandApplicationSetManifest(current); err == nil {
+		// The namespace is defaulted by ArgoCD when omitted
+		if d.Namespace == "" {
+			d.Namespace = as.Namespace
+		}
+
+		desired = newApplicationSetManifest(d)
+	}
+
+	return flattenManifest(newApplicationSetManifest(as), desired, current)
+}
@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	"github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
+	argodiff "github.com/dcoppa/argo-cd/v2/util/argo/diff"
+	"github.com/dcoppa/argo-cd/v2/util/argo/normalizers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type applicationSyncPreviewModel struct {
+	ID         types.String                     `tfsdk:"id"`
+	Name       types.String                     `tfsdk:"name"`
+	Namespace  types.String                     `tfsdk:"namespace"`
+	Revision   types.String                     `tfsdk:"revision"`
+	HasChanges types.Bool                       `tfsdk:"has_changes"`
+	Resources  []applicationSyncPreviewResource `tfsdk:"resources"`
+}
+
+func applicationSyncPreviewSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ArgoCD application identifier",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the application.",
+			Required:            true,
+		},
+		"namespace": schema.StringAttribute{
+			MarkdownDescription: "Namespace of the application. Defaults to the namespace ArgoCD is installed in.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"revision": schema.StringAttribute{
+			MarkdownDescription: "Revision to preview the sync to (e.g. a branch, tag or commit SHA). Defaults to the application target revision. Only supported for applications with a single source. **Note**: when set, changes are computed by the provider the way ArgoCD would (including the `ignore_difference` rules of the application), except for changes to the data of secrets, which ArgoCD does not expose.",
+			Optional:            true,
+		},
+		"has_changes": schema.BoolAttribute{
+			MarkdownDescription: "Whether syncing the application would change any resource.",
+			Computed:            true,
+		},
+		"resources": schema.ListNestedAttribute{
+			MarkdownDescription: "Resources that would be changed by syncing the application.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"group": schema.StringAttribute{
+						MarkdownDescription: "The Kubernetes resource Group.",
+						Computed:            true,
+					},
+					"kind": schema.StringAttribute{
+						MarkdownDescription: "The Kubernetes resource Kind.",
+						Computed:            true,
+					},
+					"namespace": schema.StringAttribute{
+						MarkdownDescription: "The Kubernetes resource Namespace.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The Kubernetes resource Name.",
+						Computed:            true,
+					},
+					"action": schema.StringAttribute{
+						MarkdownDescription: "Change that a sync would apply to the resource, one of `create`, `update` or `delete` (the latter only happens if pruning is enabled).",
+						Computed:            true,
+					},
+					"hook": schema.BoolAttribute{
+						MarkdownDescription: "Whether the resource is a sync hook.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+type applicationSyncPreviewResource struct {
+	Group     types.String `tfsdk:"group"`
+	Kind      types.String `tfsdk:"kind"`
+	Namespace types.String `tfsdk:"namespace"`
+	Name      types.String `tfsdk:"name"`
+	Action    types.String `tfsdk:"action"`
+	Hook      types.Bool   `tfsdk:"hook"`
+}
+
+// newApplicationSyncPreviewResources returns the resources whose live state
+// differs from their target state, along with the change a sync would apply.
+func newApplicationSyncPreviewResources(diffs []*v1alpha1.ResourceDiff) []applicationSyncPreviewResource {
+	var rs []applicationSyncPreviewResource
+
+	for _, d := range diffs {
+		if d == nil {
+			continue
+		}
+
+		var action string
+
+		switch {
+		case d.LiveState == "null" && d.TargetState != "null":
+			action = "create"
+		case d.TargetState == "null" && d.LiveState != "null":
+			action = "delete"
+		case d.Modified:
+			action = "update"
+		default:
+			continue
+		}
+
+		rs = append(rs, applicationSyncPreviewResource{
+			Group:     types.StringValue(d.Group),
+			Kind:      types.StringValue(d.Kind),
+			Namespace: types.StringValue(d.Namespace),
+			Name:      types.StringValue(d.Name),
+			Action:    types.StringValue(action),
+			Hook:      types.BoolValue(d.Hook),
+		})
+	}
+
+	return rs
+}
+
+type applicationSyncPreviewResourceKey struct {
+	group, kind, namespace, name string
+}
+
+// newApplicationSyncPreviewResourcesAtRevision returns the resources that
+// would change if the application was synced to the target state described by
+// manifests (i.e. the application manifests rendered at a given revision).
+// Resources are reported as updated when ArgoCD would diff their live state
+// against their target state, taking the ignored differences of the
+// application into account. Target resources without a namespace default to
+// namespace, the application destination namespace.
+func newApplicationSyncPreviewResourcesAtRevision(diffs []*v1alpha1.ResourceDiff, manifests []string, namespace string, ignoreDifferences []v1alpha1.ResourceIgnoreDifferences) ([]applicationSyncPreviewResource, error) {
+	var rs []applicationSyncPreviewResource
+
+	if ignoreDifferences == nil {
+		ignoreDifferences = []v1alpha1.ResourceIgnoreDifferences{}
+	}
+
+	diffConfig, err := argodiff.NewDiffConfigBuilder().
+		WithDiffSettings(ignoreDifferences, map[string]v1alpha1.ResourceOverride{}, false, normalizers.IgnoreNormalizerOpts{}).
+		WithNoCache().
+		Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build diff configuration: %w", err)
+	}
+
+	live := make(map[applicationSyncPreviewResourceKey]*v1alpha1.ResourceDiff)
+
+	for _, d := range diffs {
+		if d == nil || d.LiveState == "null" || d.LiveState == "" {
+			continue
+		}
+
+		live[applicationSyncPreviewResourceKey{d.Group, d.Kind, d.Namespace, d.Name}] = d
+	}
+
+	seen := make(map[applicationSyncPreviewResourceKey]bool)
+
+	for _, m := range manifests {
+		var obj unstructured.Unstructured
+
+		if err := obj.UnmarshalJSON([]byte(m)); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal manifest: %w", err)
+		}
+
+		gvk := obj.GroupVersionKind()
+		k := applicationSyncPreviewResourceKey{gvk.Group, gvk.Kind, obj.GetNamespace(), obj.GetName()}
+
+		if k.namespace == "" {
+			if _, ok := live[applicationSyncPreviewResourceKey{k.group, k.kind, namespace, k.name}]; ok {
+				k.namespace = namespace
+			}
+		}
+
+		seen[k] = true
+
+		var action string
+
+		if d, ok := live[k]; !ok {
+			action = "create"
+		} else {
+			var l unstructured.Unstructured
+
+			if err := l.UnmarshalJSON([]byte(d.LiveState)); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal live state of %s/%s: %w", k.kind, k.name, err)
+			}
+
+			res, err := argodiff.StateDiff(&l, &obj, diffConfig)
+			if err != nil {
+				return nil, fmt.Errorf("failed to diff %s/%s: %w", k.kind, k.name, err)
+			}
+
+			if !res.Modified {
+				continue
+			}
+
+			action = "update"
+		}
+
+		rs = append(rs, applicationSyncPreviewResource{
+			Group:     types.StringValue(k.group),
+			Kind:      types.StringValue(k.kind),
+			Namespace: types.StringValue(k.namespace),
+			Name:      types.StringValue(k.name),
+			Action:    types.StringValue(action),
+			Hook:      types.BoolValue(hook.IsHook(&obj)),
+		})
+	}
+
+	for _, d := range diffs {
+		if d == nil {
+			continue
+		}
+
+		k := applicationSyncPreviewResourceKey{d.Group, d.Kind, d.Namespace, d.Name}
+		if _, ok := live[k]; !ok || seen[k] {
+			continue
+		}
+
+		rs = append(rs, applicationSyncPreviewResource{
+			Group:     types.StringValue(d.Group),
+			Kind:      types.StringValue(d.Kind),
+			Namespace: types.StringValue(d.Namespace),
+			Name:      types.StringValue(d.Name),
+			Action:    types.StringValue("delete"),
+			Hook:      types.BoolValue(d.Hook),
+		})
+	}
+
+	return rs, nil
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewApplicationSyncPreviewResourcesAtRevision(t *testing.T) {
+	t.Parallel()
+
+	const target = `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test","namespace":"default"},"data":{"foo":"bar"}}`
+
+	tests := []struct {
+		name              string
+		live              string
+		manifest          string
+		ignoreDifferences []v1alpha1.ResourceIgnoreDifferences
+		want              string
+	}{
+		{
+			name:     "fields set by the server",
+			live:     `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test","namespace":"default","uid":"3c1e1b8e","resourceVersion":"42"},"data":{"foo":"bar"}}`,
+			manifest: target,
+		},
+		{
+			name:     "changed field",
+			live:     `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test","namespace":"default"},"data":{"foo":"baz"}}`,
+			manifest: target,
+			want:     "update",
+		},
+		{
+			name:     "removed field",
+			live:     `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test","namespace":"default","annotations":{"kubectl.kubernetes.io/last-applied-configuration":"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"test\",\"namespace\":\"default\"},\"data\":{\"foo\":\"bar\",\"baz\":\"qux\"}}"}},"data":{"foo":"bar","baz":"qux"}}`,
+			manifest: target,
+			want:     "update",
+		},
+		{
+			name:     "ignored difference",
+			live:     `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test","namespace":"default"},"data":{"foo":"baz"}}`,
+			manifest: target,
+			ignoreDifferences: []v1alpha1.ResourceIgnoreDifferences{
+				{
+					Kind:         "ConfigMap",
+					JSONPointers: []string{"/data/foo"},
+				},
+			},
+		},
+		{
+			name:     "new resource",
+			manifest: target,
+			want:     "create",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var diffs []*v1alpha1.ResourceDiff
+
+			if tt.live != "" {
+				diffs = append(diffs, &v1alpha1.ResourceDiff{
+					Kind:      "ConfigMap",
+					Namespace: "default",
+					Name:      "test",
+					LiveState: tt.live,
+				})
+			}
+
+			rs, err := newApplicationSyncPreviewResourcesAtRevision(diffs, []string{tt.manifest}, "default", tt.ignoreDifferences)
+			require.NoError(t, err)
+
+			if tt.want == "" {
+				assert.Empty(t, rs)
+				return
+			}
+
+			require.Len(t, rs, 1)
+			assert.Equal(t, tt.want, rs[0].Action.ValueString())
+		})
+	}
+}
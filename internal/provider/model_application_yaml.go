@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	customtypes "github.com/oboukili/terraform-provider-argocd/internal/types"
+)
+
+type applicationYAMLModel struct {
+	ID       types.String         `tfsdk:"id"`
+	Manifest customtypes.Manifest `tfsdk:"manifest"`
+	Cascade  types.Bool           `tfsdk:"cascade"`
+	Validate types.Bool           `tfsdk:"validate"`
+}
+
+func applicationYAMLSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ArgoCD application identifier",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"manifest": schema.StringAttribute{
+			MarkdownDescription: "Full `Application` manifest, in YAML or JSON format. Only `metadata.name`, `metadata.namespace`, `metadata.labels`, `metadata.annotations`, `metadata.finalizers` and `spec` are managed, any other field (e.g. `status`) is ignored. `metadata.namespace` defaults to the namespace ArgoCD is running in. Fields defaulted by ArgoCD are not reported as differences. Changing the name or namespace of the application will force the creation of a new resource.",
+			CustomType:          customtypes.ManifestType,
+			Required:            true,
+		},
+		"cascade": schema.BoolAttribute{
+			MarkdownDescription: "Whether to applying cascading deletion when application is removed.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"validate": schema.BoolAttribute{
+			MarkdownDescription: "Whether to validate the application spec before creating or updating the application.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+	}
+}
+
+// applicationManifest is the subset of an Application that is managed through
+// the `manifest` attribute.
+type applicationManifest struct {
+	APIVersion string                   `json:"apiVersion"`
+	Kind       string                   `json:"kind"`
+	Metadata   manifestMetadata         `json:"metadata"`
+	Spec       v1alpha1.ApplicationSpec `json:"spec"`
+}
+
+func newApplicationManifest(app *v1alpha1.Application) applicationManifest {
+	return applicationManifest{
+		APIVersion: manifestAPIVersion,
+		Kind:       "Application",
+		Metadata:   newManifestMetadata(app),
+		Spec:       app.Spec,
+	}
+}
+
+// expandApplicationManifest parses a YAML or JSON Application manifest.
+func expandApplicationManifest(manifest string) (*v1alpha1.Application, error) {
+	var app v1alpha1.Application
+
+	if err := expandManifest(manifest, "Application", &app); err != nil {
+		return nil, err
+	}
+
+	return &v1alpha1.Application{
+		TypeMeta:   app.TypeMeta,
+		ObjectMeta: managedObjectMeta(&app),
+		Spec:       app.Spec,
+	}, nil
+}
+
+// flattenApplicationManifest returns the live application as a YAML manifest,
+// or the `current` manifest if it matches the live application, see
+// flattenManifest.
+func flattenApplicationManifest(app *v1alpha1.Application, current string) (string, error) {
+	var desired interface{}
+
+	if d, err := expandApplicationManifest(current); err == nil {
+		// The namespace is defaulted by ArgoCD when omitted
+		if d.Namespace == "" {
+			d.Namespace = app.Namespace
+		}
+
+		desired = newApplicationManifest(d)
+	}
+
+	return flattenManifest(newApplicationManifest(app), desired, current)
+}
@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/oboukili/terraform-provider-argocd/internal/utils"
+)
+
+type clusterModel struct {
+	ID               types.String            `tfsdk:"id"`
+	Server           types.String            `tfsdk:"server"`
+	Name             types.String            `tfsdk:"name"`
+	Project          types.String            `tfsdk:"project"`
+	Namespaces       []types.String          `tfsdk:"namespaces"`
+	ClusterResources types.Bool              `tfsdk:"cluster_resources"`
+	Shard            types.Int64             `tfsdk:"shard"`
+	Labels           map[string]types.String `tfsdk:"labels"`
+	Annotations      map[string]types.String `tfsdk:"annotations"`
+	Config           clusterConfig           `tfsdk:"config"`
+	Info             clusterInfo             `tfsdk:"info"`
+}
+
+func clusterSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ArgoCD cluster identifier",
+			Computed:            true,
+		},
+		"server": schema.StringAttribute{
+			MarkdownDescription: "API server URL of the cluster. Exactly one of `server` or `name` must be set.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the cluster. Exactly one of `server` or `name` must be set.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"project": schema.StringAttribute{
+			MarkdownDescription: "Name of the project the cluster is scoped to, if any.",
+			Computed:            true,
+		},
+		"namespaces": schema.ListAttribute{
+			MarkdownDescription: "List of namespaces which are accessible in that cluster. Cluster level resources are ignored if not empty.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"cluster_resources": schema.BoolAttribute{
+			MarkdownDescription: "Whether cluster level resources are managed, when `namespaces` is not empty.",
+			Computed:            true,
+		},
+		"shard": schema.Int64Attribute{
+			MarkdownDescription: "Shard number of the application controller the cluster is assigned to, if any.",
+			Computed:            true,
+		},
+		"labels": schema.MapAttribute{
+			MarkdownDescription: "Labels of the cluster secret.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"annotations": schema.MapAttribute{
+			MarkdownDescription: "Annotations of the cluster secret.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"config": schema.SingleNestedAttribute{
+			MarkdownDescription: "Non-sensitive settings used to connect to the cluster. Credentials are never returned by ArgoCD.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"username": schema.StringAttribute{
+					MarkdownDescription: "Username for servers that require Basic authentication.",
+					Computed:            true,
+				},
+				"tls_client_config": schema.SingleNestedAttribute{
+					MarkdownDescription: "Settings to enable transport layer security when connecting to the cluster.",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"ca_data": schema.StringAttribute{
+							MarkdownDescription: "PEM-encoded bytes (typically read from a root certificates bundle).",
+							Computed:            true,
+						},
+						"insecure": schema.BoolAttribute{
+							MarkdownDescription: "Whether the server is accessed without verifying the TLS certificate.",
+							Computed:            true,
+						},
+						"server_name": schema.StringAttribute{
+							MarkdownDescription: "Name passed to the server for SNI and used to check server certificates against.",
+							Computed:            true,
+						},
+					},
+				},
+				"aws_auth_config": schema.SingleNestedAttribute{
+					MarkdownDescription: "Configuration for authenticating to EKS clusters through the AWS IAM Authenticator, if any.",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"cluster_name": schema.StringAttribute{
+							MarkdownDescription: "AWS cluster name.",
+							Computed:            true,
+						},
+						"role_arn": schema.StringAttribute{
+							MarkdownDescription: "IAM role ARN assumed to perform cluster operations.",
+							Computed:            true,
+						},
+						"profile": schema.StringAttribute{
+							MarkdownDescription: "AWS profile used to perform cluster operations.",
+							Computed:            true,
+						},
+					},
+				},
+				"exec_provider_config": schema.SingleNestedAttribute{
+					MarkdownDescription: "Configuration for the exec provider used to perform cluster authentication, if any. Arguments and environment variables are not returned by ArgoCD.",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"api_version": schema.StringAttribute{
+							MarkdownDescription: "Preferred input version of the ExecInfo.",
+							Computed:            true,
+						},
+						"command": schema.StringAttribute{
+							MarkdownDescription: "Command to execute.",
+							Computed:            true,
+						},
+						"install_hint": schema.StringAttribute{
+							MarkdownDescription: "Text shown to the user when the executable doesn't seem to be present.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		"info": schema.SingleNestedAttribute{
+			MarkdownDescription: "Live state of the cluster, as observed by the application controller.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"server_version": schema.StringAttribute{
+					MarkdownDescription: "Kubernetes version of the cluster.",
+					Computed:            true,
+				},
+				"applications_count": schema.Int64Attribute{
+					MarkdownDescription: "Number of applications managed by ArgoCD on the cluster.",
+					Computed:            true,
+				},
+				"api_versions": schema.ListAttribute{
+					MarkdownDescription: "API versions served by the cluster, as discovered by the application controller (e.g. `apps/v1/Deployment`).",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"connection_state": schema.SingleNestedAttribute{
+					MarkdownDescription: "Information about the connection to the cluster.",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Current status indicator for the connection, i.e. `Successful`, `Failed` or `Unknown`.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Human readable information about the connection status.",
+							Computed:            true,
+						},
+						"modified_at": schema.StringAttribute{
+							MarkdownDescription: "Time at which the connection status has been determined.",
+							Computed:            true,
+						},
+					},
+				},
+				"cache_info": schema.SingleNestedAttribute{
+					MarkdownDescription: "Information about the cluster cache of the application controller.",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"apis_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of observed Kubernetes APIs.",
+							Computed:            true,
+						},
+						"resources_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of observed Kubernetes resources.",
+							Computed:            true,
+						},
+						"last_cache_sync_time": schema.StringAttribute{
+							MarkdownDescription: "Time of the most recent cache synchronization.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newCluster(c *v1alpha1.Cluster) clusterModel {
+	return clusterModel{
+		ID:               types.StringValue(c.Server),
+		Server:           types.StringValue(c.Server),
+		Name:             types.StringValue(c.Name),
+		Project:          types.StringValue(c.Project),
+		Namespaces:       pie.Map(c.Namespaces, types.StringValue),
+		ClusterResources: types.BoolValue(c.ClusterResources),
+		Shard:            utils.OptionalInt64(c.Shard),
+		Labels:           utils.MapMap(c.Labels, types.StringValue),
+		Annotations:      utils.MapMap(c.Annotations, types.StringValue),
+		Config:           newClusterConfig(c.Config),
+		Info:             newClusterInfo(c.Info),
+	}
+}
+
+type clusterConfig struct {
+	Username           types.String               `tfsdk:"username"`
+	TLSClientConfig    clusterTLSClientConfig     `tfsdk:"tls_client_config"`
+	AWSAuthConfig      *clusterAWSAuthConfig      `tfsdk:"aws_auth_config"`
+	ExecProviderConfig *clusterExecProviderConfig `tfsdk:"exec_provider_config"`
+}
+
+type clusterTLSClientConfig struct {
+	CAData     types.String `tfsdk:"ca_data"`
+	Insecure   types.Bool   `tfsdk:"insecure"`
+	ServerName types.String `tfsdk:"server_name"`
+}
+
+type clusterAWSAuthConfig struct {
+	ClusterName types.String `tfsdk:"cluster_name"`
+	RoleARN     types.String `tfsdk:"role_arn"`
+	Profile     types.String `tfsdk:"profile"`
+}
+
+type clusterExecProviderConfig struct {
+	APIVersion  types.String `tfsdk:"api_version"`
+	Command     types.String `tfsdk:"command"`
+	InstallHint types.String `tfsdk:"install_hint"`
+}
+
+func newClusterConfig(cc v1alpha1.ClusterConfig) clusterConfig {
+	c := clusterConfig{
+		Username: types.StringValue(cc.Username),
+		TLSClientConfig: clusterTLSClientConfig{
+			CAData:     types.StringValue(string(cc.TLSClientConfig.CAData)),
+			Insecure:   types.BoolValue(cc.TLSClientConfig.Insecure),
+			ServerName: types.StringValue(cc.TLSClientConfig.ServerName),
+		},
+	}
+
+	if cc.AWSAuthConfig != nil {
+		c.AWSAuthConfig = &clusterAWSAuthConfig{
+			ClusterName: types.StringValue(cc.AWSAuthConfig.ClusterName),
+			RoleARN:     types.StringValue(cc.AWSAuthConfig.RoleARN),
+			Profile:     types.StringValue(cc.AWSAuthConfig.Profile),
+		}
+	}
+
+	if cc.ExecProviderConfig != nil {
+		c.ExecProviderConfig = &clusterExecProviderConfig{
+			APIVersion:  types.StringValue(cc.ExecProviderConfig.APIVersion),
+			Command:     types.StringValue(cc.ExecProviderConfig.Command),
+			InstallHint: types.StringValue(cc.ExecProviderConfig.InstallHint),
+		}
+	}
+
+	return c
+}
+
+type clusterInfo struct {
+	ServerVersion     types.String           `tfsdk:"server_version"`
+	ApplicationsCount types.Int64            `tfsdk:"applications_count"`
+	APIVersions       []types.String         `tfsdk:"api_versions"`
+	ConnectionState   clusterConnectionState `tfsdk:"connection_state"`
+	CacheInfo         clusterCacheInfo       `tfsdk:"cache_info"`
+}
+
+type clusterConnectionState struct {
+	Status     types.String `tfsdk:"status"`
+	Message    types.String `tfsdk:"message"`
+	ModifiedAt types.String `tfsdk:"modified_at"`
+}
+
+type clusterCacheInfo struct {
+	APIsCount         types.Int64  `tfsdk:"apis_count"`
+	ResourcesCount    types.Int64  `tfsdk:"resources_count"`
+	LastCacheSyncTime types.String `tfsdk:"last_cache_sync_time"`
+}
+
+func newClusterInfo(ci v1alpha1.ClusterInfo) clusterInfo {
+	return clusterInfo{
+		ServerVersion:     types.StringValue(ci.ServerVersion),
+		ApplicationsCount: types.Int64Value(ci.ApplicationsCount),
+		APIVersions:       pie.Map(ci.APIVersions, types.StringValue),
+		ConnectionState: clusterConnectionState{
+			Status:     types.StringValue(ci.ConnectionState.Status),
+			Message:    types.StringValue(ci.ConnectionState.Message),
+			ModifiedAt: utils.OptionalTimeString(ci.ConnectionState.ModifiedAt),
+		},
+		CacheInfo: clusterCacheInfo{
+			APIsCount:         types.Int64Value(ci.CacheInfo.APIsCount),
+			ResourcesCount:    types.Int64Value(ci.CacheInfo.ResourcesCount),
+			LastCacheSyncTime: utils.OptionalTimeString(ci.CacheInfo.LastCacheSyncTime),
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/oboukili/terraform-provider-argocd/internal/utils"
+)
+
+type clustersModel struct {
+	ID         types.String            `tfsdk:"id"`
+	NamePrefix types.String            `tfsdk:"name_prefix"`
+	Labels     map[string]types.String `tfsdk:"labels"`
+	Project    types.String            `tfsdk:"project"`
+	Clusters   []clustersItem          `tfsdk:"clusters"`
+}
+
+func clustersSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Identifier of the data source.",
+			Computed:            true,
+		},
+		"name_prefix": schema.StringAttribute{
+			MarkdownDescription: "Only return the clusters whose name starts with this prefix.",
+			Optional:            true,
+		},
+		"labels": schema.MapAttribute{
+			MarkdownDescription: "Only return the clusters whose secret carries all of these labels.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"project": schema.StringAttribute{
+			MarkdownDescription: "Only return the clusters scoped to this project.",
+			Optional:            true,
+		},
+		"clusters": schema.ListNestedAttribute{
+			MarkdownDescription: "Matching clusters, sorted by server URL.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"server": schema.StringAttribute{
+						MarkdownDescription: "API server URL of the cluster.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the cluster.",
+						Computed:            true,
+					},
+					"project": schema.StringAttribute{
+						MarkdownDescription: "Name of the project the cluster is scoped to, if any.",
+						Computed:            true,
+					},
+					"labels": schema.MapAttribute{
+						MarkdownDescription: "Labels of the cluster secret.",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+type clustersItem struct {
+	Server  types.String            `tfsdk:"server"`
+	Name    types.String            `tfsdk:"name"`
+	Project types.String            `tfsdk:"project"`
+	Labels  map[string]types.String `tfsdk:"labels"`
+}
+
+// matches returns whether the cluster satisfies the filters of the data
+// source.
+func (m clustersModel) matches(c v1alpha1.Cluster) bool {
+	if !strings.HasPrefix(c.Name, m.NamePrefix.ValueString()) {
+		return false
+	}
+
+	if !m.Project.IsNull() && c.Project != m.Project.ValueString() {
+		return false
+	}
+
+	for k, v := range m.Labels {
+		if l, ok := c.Labels[k]; !ok || l != v.ValueString() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func newClustersItem(c v1alpha1.Cluster) clustersItem {
+	return clustersItem{
+		Server:  types.StringValue(c.Server),
+		Name:    types.StringValue(c.Name),
+		Project: types.StringValue(c.Project),
+		Labels:  utils.MapMap(c.Labels, types.StringValue),
+	}
+}
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	customtypes "github.com/oboukili/terraform-provider-argocd/internal/types"
+	"github.com/oboukili/terraform-provider-argocd/internal/utils"
+	"sigs.k8s.io/yaml"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const manifestAPIVersion = "argoproj.io/v1alpha1"
+
+// manifestObject is an ArgoCD custom resource managed through a raw
+// `manifest` attribute.
+type manifestObject interface {
+	metav1.Object
+	GetObjectKind() k8sschema.ObjectKind
+}
+
+// manifestMetadata is the subset of the metadata of an ArgoCD custom resource
+// that is managed through a `manifest` attribute.
+type manifestMetadata struct {
+	Name        string            `json:"name"`
+	Namespace   string            `json:"namespace,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Finalizers  []string          `json:"finalizers,omitempty"`
+}
+
+func newManifestMetadata(o metav1.Object) manifestMetadata {
+	return manifestMetadata{
+		Name:        o.GetName(),
+		Namespace:   o.GetNamespace(),
+		Labels:      o.GetLabels(),
+		Annotations: o.GetAnnotations(),
+		Finalizers:  o.GetFinalizers(),
+	}
+}
+
+// managedObjectMeta returns the metadata of o that is managed through a
+// `manifest` attribute, see manifestMetadata.
+func managedObjectMeta(o metav1.Object) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        o.GetName(),
+		Namespace:   o.GetNamespace(),
+		Labels:      o.GetLabels(),
+		Annotations: o.GetAnnotations(),
+		Finalizers:  o.GetFinalizers(),
+	}
+}
+
+// expandManifest parses a YAML or JSON manifest into obj, and ensures that it
+// describes a named ArgoCD custom resource of the given kind.
+func expandManifest(manifest, kind string, obj manifestObject) error {
+	if err := yaml.UnmarshalStrict([]byte(manifest), obj); err != nil {
+		return fmt.Errorf("failed to parse %s manifest: %w", kind, err)
+	}
+
+	apiVersion, k := obj.GetObjectKind().GroupVersionKind().ToAPIVersionAndKind()
+
+	if k != kind {
+		return fmt.Errorf("manifest kind must be %s, got %q", kind, k)
+	}
+
+	if apiVersion != manifestAPIVersion {
+		return fmt.Errorf("manifest apiVersion must be %s, got %q", manifestAPIVersion, apiVersion)
+	}
+
+	if obj.GetName() == "" {
+		return fmt.Errorf("manifest metadata.name must be set")
+	}
+
+	return nil
+}
+
+// flattenManifest returns live as a YAML manifest, unless every field set in
+// desired holds the same value in live (which may hold fields defaulted by
+// ArgoCD), in which case current is returned as-is so as to preserve user
+// formatting. desired is nil when current is not a valid manifest.
+func flattenManifest(live, desired interface{}, current string) (string, error) {
+	if desired != nil {
+		if ok, err := utils.JSONContains(live, desired); err == nil && ok {
+			return current, nil
+		}
+	}
+
+	j, err := json.Marshal(live)
+	if err != nil {
+		return "", err
+	}
+
+	y, err := yaml.JSONToYAML(j)
+	if err != nil {
+		return "", err
+	}
+
+	return string(y), nil
+}
+
+// modifyManifestPlan requires the replacement of a resource managed through a
+// `manifest` attribute when the name or namespace of the described object
+// changes. Omitted namespaces are defaulted by ArgoCD, and are hence compared
+// as the namespace held in the ID of the resource.
+func modifyManifestPlan[T metav1.Object](ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, resourceName string, expand func(string) (T, error)) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var (
+		id          types.String
+		plan, state customtypes.Manifest
+	)
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("manifest"), &plan)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("manifest"), &state)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+
+	if resp.Diagnostics.HasError() || plan.IsUnknown() || state.IsNull() {
+		return
+	}
+
+	planned, err := expand(plan.ValueManifest())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("manifest"), fmt.Sprintf("Invalid %s manifest", resourceName), err.Error())
+		return
+	}
+
+	current, err := expand(state.ValueManifest())
+	if err != nil {
+		return
+	}
+
+	_, namespace, diags := manifestResourceID(id.ValueString(), resourceName)
+	if diags.HasError() {
+		return
+	}
+
+	plannedNamespace, currentNamespace := planned.GetNamespace(), current.GetNamespace()
+
+	if plannedNamespace == "" {
+		plannedNamespace = namespace
+	}
+
+	if currentNamespace == "" {
+		currentNamespace = namespace
+	}
+
+	if planned.GetName() != current.GetName() || plannedNamespace != currentNamespace {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("manifest"))
+	}
+}
+
+// manifestResourceID returns the name and namespace of the object managed by
+// a resource through a `manifest` attribute, from the ID of the resource.
+func manifestResourceID(id, resourceName string) (string, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(id, ":")
+	if len(ids) != 2 {
+		diags.AddError(fmt.Sprintf("invalid %s ID %q, expected format is <name>:<namespace>", resourceName, id), "")
+		return "", "", diags
+	}
+
+	return ids[0], ids[1], diags
+}
+
+// waitForManifestObjectDeletion waits for the object managed by a resource
+// through a `manifest` attribute to be deleted, get returning the error
+// returned by ArgoCD upon reading the object.
+func waitForManifestObjectDeletion(ctx context.Context, resourceName, name string, get func() error) error {
+	return retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
+		err := get()
+
+		switch {
+		case err == nil:
+			return retry.RetryableError(fmt.Errorf("%s %s is still present", resourceName, name))
+		case !strings.Contains(err.Error(), "NotFound"):
+			return retry.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,394 @@
+package provider
+
+import (
+	"github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type projectModel struct {
+	ID       types.String `tfsdk:"id"`
+	Metadata objectMeta   `tfsdk:"metadata"`
+	Spec     *projectSpec `tfsdk:"spec"`
+}
+
+type projectSpec struct {
+	ClusterResourceBlacklist   []projectGroupKind        `tfsdk:"cluster_resource_blacklist"`
+	ClusterResourceWhitelist   []projectGroupKind        `tfsdk:"cluster_resource_whitelist"`
+	Description                types.String              `tfsdk:"description"`
+	Destinations               []applicationDestination  `tfsdk:"destination"`
+	NamespaceResourceBlacklist []projectGroupKind        `tfsdk:"namespace_resource_blacklist"`
+	NamespaceResourceWhitelist []projectGroupKind        `tfsdk:"namespace_resource_whitelist"`
+	OrphanedResources          *projectOrphanedResources `tfsdk:"orphaned_resources"`
+	Roles                      []projectRole             `tfsdk:"role"`
+	SignatureKeys              []types.String            `tfsdk:"signature_keys"`
+	SourceNamespaces           []types.String            `tfsdk:"source_namespaces"`
+	SourceRepos                []types.String            `tfsdk:"source_repos"`
+	SyncWindows                []projectSyncWindow       `tfsdk:"sync_window"`
+}
+
+func projectSpecSchemaAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "ArgoCD AppProject spec.",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"cluster_resource_blacklist": projectGroupKindSchemaAttribute("Blacklisted cluster level resources."),
+			"cluster_resource_whitelist": projectGroupKindSchemaAttribute("Whitelisted cluster level resources."),
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Project description.",
+				Computed:            true,
+			},
+			"destination": schema.ListNestedAttribute{
+				MarkdownDescription: "Destinations available for deployment.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"server": schema.StringAttribute{
+							MarkdownDescription: "URL of the target cluster and must be set to the Kubernetes control plane API.",
+							Computed:            true,
+						},
+						"namespace": schema.StringAttribute{
+							MarkdownDescription: "Target namespace for applications' resources.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the destination cluster which can be used instead of server.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"namespace_resource_blacklist": projectGroupKindSchemaAttribute("Blacklisted namespace level resources."),
+			"namespace_resource_whitelist": projectGroupKindSchemaAttribute("Whitelisted namespace level resources."),
+			"orphaned_resources":           projectOrphanedResourcesSchemaAttribute(),
+			"role":                         projectRoleSchemaAttribute(),
+			"signature_keys": schema.ListAttribute{
+				MarkdownDescription: "List of PGP key IDs that commits in Git must be signed with in order to be allowed for sync.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"source_namespaces": schema.ListAttribute{
+				MarkdownDescription: "List of namespaces that application resources are allowed to be created in.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"source_repos": schema.ListAttribute{
+				MarkdownDescription: "List of repository URLs which can be used for deployment.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"sync_window": projectSyncWindowSchemaAttribute(),
+		},
+	}
+}
+
+func newProjectSpec(p v1alpha1.AppProject) *projectSpec {
+	s := p.Spec
+
+	m := &projectSpec{
+		ClusterResourceBlacklist:   newProjectGroupKinds(s.ClusterResourceBlacklist),
+		ClusterResourceWhitelist:   newProjectGroupKinds(s.ClusterResourceWhitelist),
+		Description:                types.StringValue(s.Description),
+		NamespaceResourceBlacklist: newProjectGroupKinds(s.NamespaceResourceBlacklist),
+		NamespaceResourceWhitelist: newProjectGroupKinds(s.NamespaceResourceWhitelist),
+		OrphanedResources:          newProjectOrphanedResources(s.OrphanedResources),
+		SourceNamespaces:           pie.Map(s.SourceNamespaces, types.StringValue),
+		SourceRepos:                pie.Map(s.SourceRepos, types.StringValue),
+		SyncWindows:                newProjectSyncWindows(s.SyncWindows),
+	}
+
+	for _, d := range s.Destinations {
+		m.Destinations = append(m.Destinations, newApplicationDestination(d))
+	}
+
+	for _, r := range s.Roles {
+		m.Roles = append(m.Roles, newProjectRole(r, p.Status.JWTTokensByRole[r.Name]))
+	}
+
+	for _, k := range s.SignatureKeys {
+		m.SignatureKeys = append(m.SignatureKeys, types.StringValue(k.KeyID))
+	}
+
+	return m
+}
+
+type projectGroupKind struct {
+	Group types.String `tfsdk:"group"`
+	Kind  types.String `tfsdk:"kind"`
+}
+
+func projectGroupKindSchemaAttribute(description string) schema.Attribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"group": schema.StringAttribute{
+					MarkdownDescription: "The Kubernetes resource Group to match for.",
+					Computed:            true,
+				},
+				"kind": schema.StringAttribute{
+					MarkdownDescription: "The Kubernetes resource Kind to match for.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func newProjectGroupKinds(gks []metav1.GroupKind) []projectGroupKind {
+	if gks == nil {
+		return nil
+	}
+
+	m := make([]projectGroupKind, len(gks))
+
+	for i, gk := range gks {
+		m[i] = projectGroupKind{
+			Group: types.StringValue(gk.Group),
+			Kind:  types.StringValue(gk.Kind),
+		}
+	}
+
+	return m
+}
+
+type projectOrphanedResources struct {
+	Warn   types.Bool                   `tfsdk:"warn"`
+	Ignore []projectOrphanedResourceKey `tfsdk:"ignore"`
+}
+
+type projectOrphanedResourceKey struct {
+	Group types.String `tfsdk:"group"`
+	Kind  types.String `tfsdk:"kind"`
+	Name  types.String `tfsdk:"name"`
+}
+
+func projectOrphanedResourcesSchemaAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Settings specifying if controller should monitor orphaned resources of apps in this project.",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"warn": schema.BoolAttribute{
+				MarkdownDescription: "Whether a warning condition should be created for apps which have orphaned resources.",
+				Computed:            true,
+			},
+			"ignore": schema.ListNestedAttribute{
+				MarkdownDescription: "List of resources that are not considered as orphaned.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group": schema.StringAttribute{
+							MarkdownDescription: "The Kubernetes resource Group to match for.",
+							Computed:            true,
+						},
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "The Kubernetes resource Kind to match for.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The Kubernetes resource name to match for.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newProjectOrphanedResources(ors *v1alpha1.OrphanedResourcesMonitorSettings) *projectOrphanedResources {
+	if ors == nil {
+		return nil
+	}
+
+	m := &projectOrphanedResources{
+		Warn: types.BoolValue(ors.IsWarn()),
+	}
+
+	for _, k := range ors.Ignore {
+		m.Ignore = append(m.Ignore, projectOrphanedResourceKey{
+			Group: types.StringValue(k.Group),
+			Kind:  types.StringValue(k.Kind),
+			Name:  types.StringValue(k.Name),
+		})
+	}
+
+	return m
+}
+
+type projectRole struct {
+	Name        types.String      `tfsdk:"name"`
+	Description types.String      `tfsdk:"description"`
+	Policies    []types.String    `tfsdk:"policies"`
+	Groups      []types.String    `tfsdk:"groups"`
+	JWTTokens   []projectJWTToken `tfsdk:"jwt_tokens"`
+}
+
+type projectJWTToken struct {
+	ID        types.String `tfsdk:"id"`
+	IssuedAt  types.Int64  `tfsdk:"issued_at"`
+	ExpiresAt types.Int64  `tfsdk:"expires_at"`
+}
+
+func projectRoleSchemaAttribute() schema.Attribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "User defined RBAC roles associated with this project.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Name of the role.",
+					Computed:            true,
+				},
+				"description": schema.StringAttribute{
+					MarkdownDescription: "Description of the role.",
+					Computed:            true,
+				},
+				"policies": schema.ListAttribute{
+					MarkdownDescription: "List of casbin formatted strings that define access policies for the role in the project.",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"groups": schema.ListAttribute{
+					MarkdownDescription: "List of OIDC group claims bound to this role.",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"jwt_tokens": schema.ListNestedAttribute{
+					MarkdownDescription: "JWT tokens issued for this role. The tokens themselves are not exposed.",
+					Computed:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								MarkdownDescription: "Token identifier.",
+								Computed:            true,
+							},
+							"issued_at": schema.Int64Attribute{
+								MarkdownDescription: "Unix timestamp at which the token was issued.",
+								Computed:            true,
+							},
+							"expires_at": schema.Int64Attribute{
+								MarkdownDescription: "Unix timestamp upon which the token will expire, `0` if it does not expire.",
+								Computed:            true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newProjectRole(r v1alpha1.ProjectRole, tokens v1alpha1.JWTTokens) projectRole {
+	m := projectRole{
+		Name:        types.StringValue(r.Name),
+		Description: types.StringValue(r.Description),
+		Policies:    pie.Map(r.Policies, types.StringValue),
+		Groups:      pie.Map(r.Groups, types.StringValue),
+	}
+
+	// Tokens used to be stored in the role spec, they are now stored in the
+	// project status
+	jwts := tokens.Items
+	if len(jwts) == 0 {
+		jwts = r.JWTTokens
+	}
+
+	for _, t := range jwts {
+		m.JWTTokens = append(m.JWTTokens, projectJWTToken{
+			ID:        types.StringValue(t.ID),
+			IssuedAt:  types.Int64Value(t.IssuedAt),
+			ExpiresAt: types.Int64Value(t.ExpiresAt),
+		})
+	}
+
+	return m
+}
+
+type projectSyncWindow struct {
+	Applications []types.String `tfsdk:"applications"`
+	Clusters     []types.String `tfsdk:"clusters"`
+	Duration     types.String   `tfsdk:"duration"`
+	Kind         types.String   `tfsdk:"kind"`
+	ManualSync   types.Bool     `tfsdk:"manual_sync"`
+	Namespaces   []types.String `tfsdk:"namespaces"`
+	Schedule     types.String   `tfsdk:"schedule"`
+	Timezone     types.String   `tfsdk:"timezone"`
+}
+
+func projectSyncWindowSchemaAttribute() schema.Attribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "Settings controlling when syncs can be run for apps in this project.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"applications": schema.ListAttribute{
+					MarkdownDescription: "List of applications that the window will apply to.",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"clusters": schema.ListAttribute{
+					MarkdownDescription: "List of clusters that the window will apply to.",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"duration": schema.StringAttribute{
+					MarkdownDescription: "Amount of time the sync window will be open.",
+					Computed:            true,
+				},
+				"kind": schema.StringAttribute{
+					MarkdownDescription: "Defines if the window allows or blocks syncs, `allow` or `deny`.",
+					Computed:            true,
+				},
+				"manual_sync": schema.BoolAttribute{
+					MarkdownDescription: "Enables manual syncs when they would otherwise be blocked.",
+					Computed:            true,
+				},
+				"namespaces": schema.ListAttribute{
+					MarkdownDescription: "List of namespaces that the window will apply to.",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"schedule": schema.StringAttribute{
+					MarkdownDescription: "Time the window will begin, specified in cron format.",
+					Computed:            true,
+				},
+				"timezone": schema.StringAttribute{
+					MarkdownDescription: "Timezone that the schedule will be evaluated in.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func newProjectSyncWindows(sws v1alpha1.SyncWindows) []projectSyncWindow {
+	if sws == nil {
+		return nil
+	}
+
+	m := make([]projectSyncWindow, 0, len(sws))
+
+	for _, sw := range sws {
+		if sw == nil {
+			continue
+		}
+
+		m = append(m, projectSyncWindow{
+			Applications: pie.Map(sw.Applications, types.StringValue),
+			Clusters:     pie.Map(sw.Clusters, types.StringValue),
+			Duration:     types.StringValue(sw.Duration),
+			Kind:         types.StringValue(sw.Kind),
+			ManualSync:   types.BoolValue(sw.ManualSync),
+			Namespaces:   pie.Map(sw.Namespaces, types.StringValue),
+			Schedule:     types.StringValue(sw.Schedule),
+			Timezone:     types.StringValue(sw.TimeZone),
+		})
+	}
+
+	return m
+}
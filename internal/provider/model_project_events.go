@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type projectEventsModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	Project             types.String   `tfsdk:"project"`
+	IncludeApplications types.Bool     `tfsdk:"include_applications"`
+	Events              []projectEvent `tfsdk:"events"`
+}
+
+func projectEventsSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ArgoCD project identifier",
+			Computed:            true,
+		},
+		"project": schema.StringAttribute{
+			MarkdownDescription: "Name of the project.",
+			Required:            true,
+		},
+		"include_applications": schema.BoolAttribute{
+			MarkdownDescription: "Whether to also return the events of the applications belonging to the project. Defaults to `false`.",
+			Optional:            true,
+		},
+		"events": schema.ListNestedAttribute{
+			MarkdownDescription: "Events, most recent first. Kubernetes only retains events for a limited time (one hour by default).",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of the event, either `Normal` or `Warning`.",
+						Computed:            true,
+					},
+					"reason": schema.StringAttribute{
+						MarkdownDescription: "Short, machine understandable reason of the event, e.g. `ResourceUpdated`.",
+						Computed:            true,
+					},
+					"message": schema.StringAttribute{
+						MarkdownDescription: "Human readable description of the event.",
+						Computed:            true,
+					},
+					"count": schema.Int64Attribute{
+						MarkdownDescription: "Number of times the event has occurred.",
+						Computed:            true,
+					},
+					"first_timestamp": schema.StringAttribute{
+						MarkdownDescription: "Time at which the event was first recorded, in RFC3339 format.",
+						Computed:            true,
+					},
+					"last_timestamp": schema.StringAttribute{
+						MarkdownDescription: "Time at which the event was most recently recorded, in RFC3339 format.",
+						Computed:            true,
+					},
+					"object_kind": schema.StringAttribute{
+						MarkdownDescription: "Kind of the object the event is about, i.e. `AppProject` or `Application`.",
+						Computed:            true,
+					},
+					"object_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the object the event is about.",
+						Computed:            true,
+					},
+					"object_namespace": schema.StringAttribute{
+						MarkdownDescription: "Namespace of the object the event is about.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+type projectEvent struct {
+	Type            types.String `tfsdk:"type"`
+	Reason          types.String `tfsdk:"reason"`
+	Message         types.String `tfsdk:"message"`
+	Count           types.Int64  `tfsdk:"count"`
+	FirstTimestamp  types.String `tfsdk:"first_timestamp"`
+	LastTimestamp   types.String `tfsdk:"last_timestamp"`
+	ObjectKind      types.String `tfsdk:"object_kind"`
+	ObjectName      types.String `tfsdk:"object_name"`
+	ObjectNamespace types.String `tfsdk:"object_namespace"`
+}
+
+// newProjectEvents returns the given events, most recent first.
+func newProjectEvents(events []corev1.Event) []projectEvent {
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventLastTime(events[i]).After(eventLastTime(events[j]))
+	})
+
+	result := make([]projectEvent, 0, len(events))
+
+	for _, e := range events {
+		first := e.FirstTimestamp.Time
+		if first.IsZero() {
+			first = e.EventTime.Time
+		}
+
+		result = append(result, projectEvent{
+			Type:            types.StringValue(e.Type),
+			Reason:          types.StringValue(e.Reason),
+			Message:         types.StringValue(e.Message),
+			Count:           types.Int64Value(int64(e.Count)),
+			FirstTimestamp:  types.StringValue(first.UTC().Format(time.RFC3339)),
+			LastTimestamp:   types.StringValue(eventLastTime(e).UTC().Format(time.RFC3339)),
+			ObjectKind:      types.StringValue(e.InvolvedObject.Kind),
+			ObjectName:      types.StringValue(e.InvolvedObject.Name),
+			ObjectNamespace: types.StringValue(e.InvolvedObject.Namespace),
+		})
+	}
+
+	return result
+}
+
+// eventLastTime returns the time at which an event was most recently
+// recorded, events created through the events.k8s.io API only having an
+// event time.
+func eventLastTime(e corev1.Event) time.Time {
+	if !e.LastTimestamp.IsZero() {
+		return e.LastTimestamp.Time
+	}
+
+	return e.EventTime.Time
+}
@@ -254,6 +254,8 @@ func (p *ArgoCDProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 func (p *ArgoCDProvider) Resources(context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewApplicationSetYAMLResource,
+		NewApplicationYAMLResource,
 		NewGPGKeyResource,
 	}
 }
@@ -261,5 +263,11 @@ func (p *ArgoCDProvider) Resources(context.Context) []func() resource.Resource {
 func (p *ArgoCDProvider) DataSources(context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewArgoCDApplicationDataSource,
+		NewArgoCDApplicationSetDataSource,
+		NewArgoCDApplicationSyncPreviewDataSource,
+		NewArgoCDClusterDataSource,
+		NewArgoCDClustersDataSource,
+		NewArgoCDProjectDataSource,
+		NewArgoCDProjectEventsDataSource,
 	}
 }
@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dcoppa/argo-cd/v2/pkg/apiclient/applicationset"
+	"github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oboukili/terraform-provider-argocd/internal/diagnostics"
+	"github.com/oboukili/terraform-provider-argocd/internal/features"
+	customtypes "github.com/oboukili/terraform-provider-argocd/internal/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &applicationSetYAMLResource{}
+	_ resource.ResourceWithImportState = &applicationSetYAMLResource{}
+	_ resource.ResourceWithModifyPlan  = &applicationSetYAMLResource{}
+)
+
+func NewApplicationSetYAMLResource() resource.Resource {
+	return &applicationSetYAMLResource{}
+}
+
+// applicationSetYAMLResource defines the resource implementation.
+type applicationSetYAMLResource struct {
+	si *ServerInterface
+}
+
+func (r *applicationSetYAMLResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_set_yaml"
+}
+
+func (r *applicationSetYAMLResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages [application sets](https://argo-cd.readthedocs.io/en/stable/user-guide/application-set/) within ArgoCD from a raw YAML or JSON manifest. Useful for complex generator trees, or for fields which are not (yet) supported by `argocd_application_set`.",
+		Attributes:          applicationSetYAMLSchemaAttributes(),
+	}
+}
+
+func (r *applicationSetYAMLResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *applicationSetYAMLResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyManifestPlan(ctx, req, resp, "application set", expandApplicationSetManifest)
+}
+
+func (r *applicationSetYAMLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data applicationSetYAMLModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	as, diags := r.expandApplicationSet(data.Manifest.ValueManifest())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.si.ApplicationSetClient.Create(ctx, &applicationset.ApplicationSetCreateRequest{
+		Applicationset: as,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("create", "application set", as.Name, err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created application set %s", created.Name))
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", created.Name, created.Namespace))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *applicationSetYAMLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data applicationSetYAMLModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name, namespace, diags := manifestResourceID(data.ID.ValueString(), "application set")
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	as, err := r.si.ApplicationSetClient.Get(ctx, &applicationset.ApplicationSetGetQuery{
+		Name:            name,
+		AppsetNamespace: namespace,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "application set", name, err)...)
+
+		return
+	}
+
+	manifest, err := flattenApplicationSetManifest(as, data.Manifest.ValueManifest())
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to flatten application set %s", name), err)...)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", as.Name, as.Namespace))
+	data.Manifest = customtypes.ManifestValue(manifest)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *applicationSetYAMLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data applicationSetYAMLModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	as, diags := r.expandApplicationSet(data.Manifest.ValueManifest())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Application sets can only be updated through an upsert
+	_, err := r.si.ApplicationSetClient.Create(ctx, &applicationset.ApplicationSetCreateRequest{
+		Applicationset: as,
+		Upsert:         true,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("update", "application set", as.Name, err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated application set %s", as.Name))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *applicationSetYAMLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data applicationSetYAMLModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name, namespace, diags := manifestResourceID(data.ID.ValueString(), "application set")
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.si.ApplicationSetClient.Delete(ctx, &applicationset.ApplicationSetDeleteRequest{
+		Name:            name,
+		AppsetNamespace: namespace,
+	})
+	if err != nil && !strings.Contains(err.Error(), "NotFound") {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("delete", "application set", name, err)...)
+		return
+	}
+
+	err = waitForManifestObjectDeletion(ctx, "application set", name, func() error {
+		_, err := r.si.ApplicationSetClient.Get(ctx, &applicationset.ApplicationSetGetQuery{
+			Name:            name,
+			AppsetNamespace: namespace,
+		})
+
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to wait for application set %s to be deleted", name), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted application set %s", name))
+}
+
+func (r *applicationSetYAMLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *applicationSetYAMLResource) expandApplicationSet(manifest string) (*v1alpha1.ApplicationSet, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	as, err := expandApplicationSetManifest(manifest)
+	if err != nil {
+		diags.AddAttributeError(path.Root("manifest"), "Invalid application set manifest", err.Error())
+		return nil, diags
+	}
+
+	if !r.si.IsFeatureSupported(features.ApplicationSet) {
+		diags.Append(diagnostics.FeatureNotSupported(features.ApplicationSet)...)
+		return nil, diags
+	}
+
+	if as.Spec.Strategy != nil && !r.si.IsFeatureSupported(features.ApplicationSetProgressiveSync) {
+		diags.Append(diagnostics.FeatureNotSupported(features.ApplicationSetProgressiveSync)...)
+	}
+
+	if len(as.Spec.IgnoreApplicationDifferences) > 0 && !r.si.IsFeatureSupported(features.ApplicationSetIgnoreApplicationDifferences) {
+		diags.Append(diagnostics.FeatureNotSupported(features.ApplicationSetIgnoreApplicationDifferences)...)
+	}
+
+	if as.Spec.SyncPolicy != nil && as.Spec.SyncPolicy.ApplicationsSync != nil && !r.si.IsFeatureSupported(features.ApplicationSetApplicationsSyncPolicy) {
+		diags.Append(diagnostics.FeatureNotSupported(features.ApplicationSetApplicationsSyncPolicy)...)
+	}
+
+	if as.Spec.TemplatePatch != nil && !r.si.IsFeatureSupported(features.ApplicationSetTemplatePatch) {
+		diags.Append(diagnostics.FeatureNotSupported(features.ApplicationSetTemplatePatch)...)
+	}
+
+	if len(as.Spec.GoTemplateOptions) > 0 && !r.si.IsFeatureSupported(features.ApplicationSetGoTemplateOptions) {
+		diags.Append(diagnostics.FeatureNotSupported(features.ApplicationSetGoTemplateOptions)...)
+	}
+
+	return as, diags
+}
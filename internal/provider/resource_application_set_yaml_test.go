@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDApplicationSetYAMLResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccArgoCDApplicationSetYAMLResource(name, "guestbook"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_application_set_yaml.this", "id", name+":argocd"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "argocd_application_set_yaml.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manifest"},
+			},
+			// Update testing
+			{
+				Config: testAccArgoCDApplicationSetYAMLResource(name, "helm-guestbook"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_application_set_yaml.this", "id", name+":argocd"),
+				),
+			},
+			// Equivalent JSON manifest should not produce a diff
+			{
+				Config: fmt.Sprintf(`
+resource "argocd_application_set_yaml" "this" {
+  manifest = jsonencode({
+    apiVersion = "argoproj.io/v1alpha1"
+    kind       = "ApplicationSet"
+    metadata = {
+      name      = "%[1]s"
+      namespace = "argocd"
+    }
+    spec = {
+      generators = [{
+        matrix = {
+          generators = [
+            {
+              list = {
+                elements = [{
+                  cluster = "in-cluster"
+                  url     = "https://kubernetes.default.svc"
+                }]
+              }
+            },
+            {
+              list = {
+                elements = [{ env = "dev" }]
+              }
+            }
+          ]
+        }
+      }]
+      template = {
+        metadata = {
+          name = "%[1]s-{{cluster}}-{{env}}"
+        }
+        spec = {
+          project = "default"
+          source = {
+            repoURL        = "https://github.com/argoproj/argocd-example-apps.git"
+            targetRevision = "HEAD"
+            path           = "helm-guestbook"
+          }
+          destination = {
+            server    = "{{url}}"
+            namespace = "%[1]s-{{env}}"
+          }
+        }
+      }
+    }
+  })
+}
+				`, name),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplicationSetYAMLResource_DefaultNamespace(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationSetYAMLResourceDefaultNamespace(name),
+				Check:  resource.TestCheckResourceAttr("argocd_application_set_yaml.this", "id", name+":argocd"),
+			},
+			// The defaulted namespace should neither produce a diff nor force a replacement
+			{
+				Config:   testAccArgoCDApplicationSetYAMLResourceDefaultNamespace(name),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplicationSetYAMLResource_Invalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "argocd_application_set_yaml" "invalid" {
+  manifest = <<EOF
+apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: invalid
+EOF
+}
+				`,
+				ExpectError: regexp.MustCompile("manifest kind must be ApplicationSet"),
+			},
+		},
+	})
+}
+
+func testAccArgoCDApplicationSetYAMLResource(name, path string) string {
+	return fmt.Sprintf(`
+resource "argocd_application_set_yaml" "this" {
+  manifest = <<EOF
+apiVersion: argoproj.io/v1alpha1
+kind: ApplicationSet
+metadata:
+  name: %[1]s
+  namespace: argocd
+spec:
+  generators:
+    - matrix:
+        generators:
+          - list:
+              elements:
+                - cluster: in-cluster
+                  url: https://kubernetes.default.svc
+          - list:
+              elements:
+                - env: dev
+  template:
+    metadata:
+      name: '%[1]s-{{cluster}}-{{env}}'
+    spec:
+      project: default
+      source:
+        repoURL: https://github.com/argoproj/argocd-example-apps.git
+        targetRevision: HEAD
+        path: %[2]s
+      destination:
+        server: '{{url}}'
+        namespace: '%[1]s-{{env}}'
+EOF
+}
+	`, name, path)
+}
+
+func testAccArgoCDApplicationSetYAMLResourceDefaultNamespace(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_application_set_yaml" "this" {
+  manifest = <<EOF
+apiVersion: argoproj.io/v1alpha1
+kind: ApplicationSet
+metadata:
+  name: %[1]s
+spec:
+  generators:
+    - list:
+        elements:
+          - cluster: in-cluster
+            url: https://kubernetes.default.svc
+  template:
+    metadata:
+      name: '%[1]s-{{cluster}}'
+    spec:
+      project: default
+      source:
+        repoURL: https://github.com/argoproj/argocd-example-apps.git
+        targetRevision: HEAD
+        path: guestbook
+      destination:
+        server: '{{url}}'
+        namespace: '%[1]s'
+EOF
+}
+	`, name)
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dcoppa/argo-cd/v2/pkg/apiclient/application"
+	"github.com/dcoppa/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oboukili/terraform-provider-argocd/internal/diagnostics"
+	"github.com/oboukili/terraform-provider-argocd/internal/features"
+	customtypes "github.com/oboukili/terraform-provider-argocd/internal/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &applicationYAMLResource{}
+	_ resource.ResourceWithImportState = &applicationYAMLResource{}
+	_ resource.ResourceWithModifyPlan  = &applicationYAMLResource{}
+)
+
+func NewApplicationYAMLResource() resource.Resource {
+	return &applicationYAMLResource{}
+}
+
+// applicationYAMLResource defines the resource implementation.
+type applicationYAMLResource struct {
+	si *ServerInterface
+}
+
+func (r *applicationYAMLResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_yaml"
+}
+
+func (r *applicationYAMLResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages [applications](https://argo-cd.readthedocs.io/en/stable/operator-manual/declarative-setup/#applications) within ArgoCD from a raw YAML or JSON manifest.",
+		Attributes:          applicationYAMLSchemaAttributes(),
+	}
+}
+
+func (r *applicationYAMLResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	si, ok := req.ProviderData.(*ServerInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *ServerInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.si = si
+}
+
+func (r *applicationYAMLResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyManifestPlan(ctx, req, resp, "application", expandApplicationManifest)
+}
+
+func (r *applicationYAMLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data applicationYAMLModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, diags := r.expandApplication(data.Manifest.ValueManifest())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validate := data.Validate.ValueBool()
+
+	created, err := r.si.ApplicationClient.Create(ctx, &application.ApplicationCreateRequest{
+		Application: app,
+		Validate:    &validate,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("create", "application", app.Name, err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created application %s", created.Name))
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", created.Name, created.Namespace))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *applicationYAMLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data applicationYAMLModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appName, namespace, diags := manifestResourceID(data.ID.ValueString(), "application")
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := r.si.ApplicationClient.Get(ctx, &application.ApplicationQuery{
+		Name:         &appName,
+		AppNamespace: &namespace,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("read", "application", appName, err)...)
+
+		return
+	}
+
+	manifest, err := flattenApplicationManifest(app, data.Manifest.ValueManifest())
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to flatten application %s", appName), err)...)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", app.Name, app.Namespace))
+	data.Manifest = customtypes.ManifestValue(manifest)
+
+	// Default values are not set when importing
+	if data.Cascade.IsNull() {
+		data.Cascade = types.BoolValue(true)
+	}
+
+	if data.Validate.IsNull() {
+		data.Validate = types.BoolValue(true)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *applicationYAMLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data applicationYAMLModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, diags := r.expandApplication(data.Manifest.ValueManifest())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validate := data.Validate.ValueBool()
+
+	_, err := r.si.ApplicationClient.Update(ctx, &application.ApplicationUpdateRequest{
+		Application: app,
+		Validate:    &validate,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("update", "application", app.Name, err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated application %s", app.Name))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *applicationYAMLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data applicationYAMLModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	// Initialize API clients
+	resp.Diagnostics.Append(r.si.InitClients(ctx)...)
+
+	// Check for errors before proceeding
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appName, namespace, diags := manifestResourceID(data.ID.ValueString(), "application")
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cascade := data.Cascade.ValueBool()
+
+	_, err := r.si.ApplicationClient.Delete(ctx, &application.ApplicationDeleteRequest{
+		Name:         &appName,
+		Cascade:      &cascade,
+		AppNamespace: &namespace,
+	})
+	if err != nil && !strings.Contains(err.Error(), "NotFound") {
+		resp.Diagnostics.Append(diagnostics.ArgoCDAPIError("delete", "application", appName, err)...)
+		return
+	}
+
+	err = waitForManifestObjectDeletion(ctx, "application", appName, func() error {
+		_, err := r.si.ApplicationClient.Get(ctx, &application.ApplicationQuery{
+			Name:         &appName,
+			AppNamespace: &namespace,
+		})
+
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.Error(fmt.Sprintf("failed to wait for application %s to be deleted", appName), err)...)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted application %s", appName))
+}
+
+func (r *applicationYAMLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *applicationYAMLResource) expandApplication(manifest string) (*v1alpha1.Application, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	app, err := expandApplicationManifest(manifest)
+	if err != nil {
+		diags.AddAttributeError(path.Root("manifest"), "Invalid application manifest", err.Error())
+		return nil, diags
+	}
+
+	if len(app.Spec.Sources) > 1 && !r.si.IsFeatureSupported(features.MultipleApplicationSources) {
+		diags.Append(diagnostics.FeatureNotSupported(features.MultipleApplicationSources)...)
+	}
+
+	if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.ManagedNamespaceMetadata != nil && !r.si.IsFeatureSupported(features.ManagedNamespaceMetadata) {
+		diags.Append(diagnostics.FeatureNotSupported(features.ManagedNamespaceMetadata)...)
+	}
+
+	return app, diags
+}
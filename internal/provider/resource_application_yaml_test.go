@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgoCDApplicationYAMLResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccArgoCDApplicationYAMLResource(name, "guestbook"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_application_yaml.this", "id", name+":argocd"),
+					resource.TestCheckResourceAttr("argocd_application_yaml.this", "cascade", "true"),
+					resource.TestCheckResourceAttr("argocd_application_yaml.this", "validate", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "argocd_application_yaml.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manifest"},
+			},
+			// Update testing
+			{
+				Config: testAccArgoCDApplicationYAMLResource(name, "helm-guestbook"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("argocd_application_yaml.this", "id", name+":argocd"),
+				),
+			},
+			// Equivalent JSON manifest should not produce a diff
+			{
+				Config: fmt.Sprintf(`
+resource "argocd_application_yaml" "this" {
+  manifest = jsonencode({
+    apiVersion = "argoproj.io/v1alpha1"
+    kind       = "Application"
+    metadata = {
+      name      = "%[1]s"
+      namespace = "argocd"
+    }
+    spec = {
+      project = "default"
+      source = {
+        repoURL        = "https://github.com/argoproj/argocd-example-apps.git"
+        targetRevision = "HEAD"
+        path           = "helm-guestbook"
+      }
+      destination = {
+        server    = "https://kubernetes.default.svc"
+        namespace = "%[1]s"
+      }
+    }
+  })
+}
+				`, name),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplicationYAMLResource_DefaultNamespace(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArgoCDApplicationYAMLResourceDefaultNamespace(name),
+				Check:  resource.TestCheckResourceAttr("argocd_application_yaml.this", "id", name+":argocd"),
+			},
+			// The defaulted namespace should neither produce a diff nor force a replacement
+			{
+				Config:   testAccArgoCDApplicationYAMLResourceDefaultNamespace(name),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccArgoCDApplicationYAMLResource_Invalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "argocd_application_yaml" "invalid" {
+  manifest = "- not\n- a\n- manifest"
+}
+				`,
+				ExpectError: regexp.MustCompile("Invalid Manifest"),
+			},
+			{
+				Config: `
+resource "argocd_application_yaml" "invalid" {
+  manifest = <<EOF
+apiVersion: argoproj.io/v1alpha1
+kind: AppProject
+metadata:
+  name: invalid
+EOF
+}
+				`,
+				ExpectError: regexp.MustCompile("manifest kind must be Application"),
+			},
+		},
+	})
+}
+
+func testAccArgoCDApplicationYAMLResource(name, path string) string {
+	return fmt.Sprintf(`
+resource "argocd_application_yaml" "this" {
+  manifest = <<EOF
+apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: %[1]s
+  namespace: argocd
+spec:
+  project: default
+  source:
+    repoURL: https://github.com/argoproj/argocd-example-apps.git
+    targetRevision: HEAD
+    path: %[2]s
+  destination:
+    server: https://kubernetes.default.svc
+    namespace: %[1]s
+EOF
+}
+	`, name, path)
+}
+
+func testAccArgoCDApplicationYAMLResourceDefaultNamespace(name string) string {
+	return fmt.Sprintf(`
+resource "argocd_application_yaml" "this" {
+  manifest = <<EOF
+apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: %[1]s
+spec:
+  project: default
+  source:
+    repoURL: https://github.com/argoproj/argocd-example-apps.git
+    targetRevision: HEAD
+    path: guestbook
+  destination:
+    server: https://kubernetes.default.svc
+    namespace: %[1]s
+EOF
+}
+	`, name)
+}
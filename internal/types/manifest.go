@@ -0,0 +1,317 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"sigs.k8s.io/yaml"
+)
+
+type manifestType uint8
+
+const (
+	ManifestType manifestType = iota
+)
+
+var (
+	_ xattr.TypeWithValidate  = ManifestType
+	_ basetypes.StringTypable = ManifestType
+
+	_ basetypes.StringValuable                   = Manifest{}
+	_ basetypes.StringValuableWithSemanticEquals = Manifest{}
+)
+
+// TerraformType returns the tftypes.Type that should be used to represent this
+// framework type.
+func (t manifestType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t manifestType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	if in.IsUnknown() {
+		return ManifestUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return ManifestNull(), nil
+	}
+
+	return Manifest{
+		state: attr.ValueStateKnown,
+		value: in.ValueString(),
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider to
+// consume the data with.
+func (t manifestType) ValueFromTerraform(_ context.Context, in tftypes.Value) (attr.Value, error) {
+	if !in.IsKnown() {
+		return ManifestUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return ManifestNull(), nil
+	}
+
+	var s string
+	err := in.As(&s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Manifest{
+		state: attr.ValueStateKnown,
+		value: s,
+	}, nil
+}
+
+// ValueType returns the Value type.
+func (t manifestType) ValueType(context.Context) attr.Value {
+	return Manifest{}
+}
+
+// Equal returns true if `o` is also a ManifestType.
+func (t manifestType) Equal(o attr.Type) bool {
+	_, ok := o.(manifestType)
+	return ok
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type.
+func (t manifestType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// String returns a human-friendly description of the ManifestType.
+func (t manifestType) String() string {
+	return "types.ManifestType"
+}
+
+// Validate implements type validation.
+func (t manifestType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !in.Type().Is(tftypes.String) {
+		diags.AddAttributeError(
+			path,
+			"Manifest Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected String value, received %T with value: %v", in, in),
+		)
+
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+
+	err := in.As(&value)
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"Manifest Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Error: %s", err),
+		)
+
+		return diags
+	}
+
+	if _, err = normalizeManifest(value); err != nil {
+		diags.AddAttributeError(
+			path,
+			"Invalid Manifest",
+			err.Error())
+
+		return diags
+	}
+
+	return diags
+}
+
+func (t manifestType) Description() string {
+	return `Kubernetes manifest in YAML or JSON format.`
+}
+
+func ManifestNull() Manifest {
+	return Manifest{
+		state: attr.ValueStateNull,
+	}
+}
+
+func ManifestUnknown() Manifest {
+	return Manifest{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func ManifestValue(value string) Manifest {
+	return Manifest{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
+type Manifest struct {
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+
+	// value contains the original string representation.
+	value string
+}
+
+// Type returns a ManifestType.
+func (m Manifest) Type(_ context.Context) attr.Type {
+	return ManifestType
+}
+
+// ToStringValue should convert the value type to a String.
+func (m Manifest) ToStringValue(ctx context.Context) (types.String, diag.Diagnostics) {
+	switch m.state {
+	case attr.ValueStateKnown:
+		return types.StringValue(m.value), nil
+	case attr.ValueStateNull:
+		return types.StringNull(), nil
+	case attr.ValueStateUnknown:
+		return types.StringUnknown(), nil
+	default:
+		return types.StringUnknown(), diag.Diagnostics{
+			diag.NewErrorDiagnostic(fmt.Sprintf("unhandled Manifest state in ToStringValue: %s", m.state), ""),
+		}
+	}
+}
+
+// ToTerraformValue returns the data contained in the *String as a string. If
+// Unknown is true, it returns a tftypes.UnknownValue. If Null is true, it
+// returns nil.
+func (m Manifest) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	t := ManifestType.TerraformType(ctx)
+
+	switch m.state {
+	case attr.ValueStateKnown:
+		if err := tftypes.ValidateValue(t, m.value); err != nil {
+			return tftypes.NewValue(t, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(t, m.value), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(t, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(t, tftypes.UnknownValue), nil
+	default:
+		return tftypes.NewValue(t, tftypes.UnknownValue), fmt.Errorf("unhandled Manifest state in ToTerraformValue: %s", m.state)
+	}
+}
+
+// Equal returns true if `other` is a *Manifest and has the same value as `m`.
+func (m Manifest) Equal(other attr.Value) bool {
+	o, ok := other.(Manifest)
+
+	if !ok {
+		return false
+	}
+
+	if m.state != o.state {
+		return false
+	}
+
+	if m.state != attr.ValueStateKnown {
+		return true
+	}
+
+	return m.value == o.value
+}
+
+// IsNull returns true if the Value is not set, or is explicitly set to null.
+func (m Manifest) IsNull() bool {
+	return m.state == attr.ValueStateNull
+}
+
+// IsUnknown returns true if the Value is not yet known.
+func (m Manifest) IsUnknown() bool {
+	return m.state == attr.ValueStateUnknown
+}
+
+// String returns a summary representation of either the underlying Value,
+// or UnknownValueString (`<unknown>`) when IsUnknown() returns true,
+// or NullValueString (`<null>`) when IsNull() return true.
+//
+// This is an intentionally lossy representation, that are best suited for
+// logging and error reporting, as they are not protected by
+// compatibility guarantees within the framework.
+func (m Manifest) String() string {
+	if m.IsUnknown() {
+		return attr.UnknownValueString
+	}
+
+	if m.IsNull() {
+		return attr.NullValueString
+	}
+
+	return m.value
+}
+
+// ValueManifest returns the known string value. If Manifest is null or unknown, returns "".
+func (m Manifest) ValueManifest() string {
+	return m.value
+}
+
+// StringSemanticEquals should return true if the given value is
+// semantically equal to the current value. Two manifests are considered equal
+// when they describe the same structure, regardless of whether they are
+// written in YAML or JSON, of the ordering of their keys or of their
+// formatting.
+//
+// Only known values are compared with this method as changing a value's
+// state implicitly represents a different value.
+func (m Manifest) StringSemanticEquals(ctx context.Context, other basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	o, ok := other.(Manifest)
+	if !ok {
+		return false, diags
+	}
+
+	a, err := normalizeManifest(m.value)
+	if err != nil {
+		return false, diags
+	}
+
+	b, err := normalizeManifest(o.value)
+	if err != nil {
+		return false, diags
+	}
+
+	return reflect.DeepEqual(a, b), diags
+}
+
+// normalizeManifest parses a YAML or JSON manifest into its generic structural
+// representation.
+func normalizeManifest(manifest string) (map[string]interface{}, error) {
+	var m map[string]interface{}
+
+	if err := yaml.Unmarshal([]byte(manifest), &m); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+
+	if m == nil {
+		return nil, fmt.Errorf("manifest must not be empty")
+	}
+
+	return m, nil
+}
@@ -1,6 +1,9 @@
 package utils
 
 import (
+	"encoding/json"
+	"reflect"
+
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
@@ -51,3 +54,86 @@ func MapMap[T comparable, U any, V any](ss map[T]U, fn func(U) V) map[T]V {
 
 	return ss2
 }
+
+// JSONContains returns whether every field set in the JSON representation of
+// desired is set to the same value in the JSON representation of live, which
+// may hold additional fields (e.g. defaulted by the ArgoCD server). Lists must
+// have the same length, their items being compared pairwise.
+func JSONContains(live, desired interface{}) (bool, error) {
+	var l, d interface{}
+
+	for _, v := range []struct {
+		in  interface{}
+		out *interface{}
+	}{{live, &l}, {desired, &d}} {
+		j, err := json.Marshal(v.in)
+		if err != nil {
+			return false, err
+		}
+
+		if err = json.Unmarshal(j, v.out); err != nil {
+			return false, err
+		}
+	}
+
+	return jsonContains(l, d), nil
+}
+
+func jsonContains(live, desired interface{}) bool {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return live == nil && len(d) == 0
+		}
+
+		for k, dv := range d {
+			lv, ok := l[k]
+			if !ok {
+				if isEmptyJSON(dv) {
+					continue
+				}
+
+				return false
+			}
+
+			if !jsonContains(lv, dv) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok {
+			return live == nil && len(d) == 0
+		}
+
+		if len(l) != len(d) {
+			return false
+		}
+
+		for i := range d {
+			if !jsonContains(l[i], d[i]) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(live, desired)
+	}
+}
+
+func isEmptyJSON(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(t) == 0
+	case []interface{}:
+		return len(t) == 0
+	default:
+		return false
+	}
+}
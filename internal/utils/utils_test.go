@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestJSONContains(t *testing.T) {
+	t.Parallel()
+
+	type spec struct {
+		Project    string            `json:"project,omitempty"`
+		Revision   string            `json:"revision,omitempty"`
+		Labels     map[string]string `json:"labels,omitempty"`
+		Namespaces []string          `json:"namespaces,omitempty"`
+	}
+
+	testCases := []struct {
+		name     string
+		live     spec
+		desired  spec
+		expected bool
+	}{
+		{
+			name:     "identical",
+			live:     spec{Project: "default", Namespaces: []string{"a", "b"}},
+			desired:  spec{Project: "default", Namespaces: []string{"a", "b"}},
+			expected: true,
+		},
+		{
+			name:     "fields defaulted by the server",
+			live:     spec{Project: "default", Revision: "HEAD", Labels: map[string]string{"foo": "bar"}},
+			desired:  spec{Project: "default"},
+			expected: true,
+		},
+		{
+			name:     "different value",
+			live:     spec{Project: "default"},
+			desired:  spec{Project: "other"},
+			expected: false,
+		},
+		{
+			name:     "missing map entry",
+			live:     spec{Labels: map[string]string{"foo": "bar"}},
+			desired:  spec{Labels: map[string]string{"foo": "bar", "baz": "qux"}},
+			expected: false,
+		},
+		{
+			name:     "additional list item",
+			live:     spec{Namespaces: []string{"a", "b"}},
+			desired:  spec{Namespaces: []string{"a"}},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ok, err := JSONContains(tc.live, tc.desired)
+			if err != nil {
+				t.Fatalf("JSONContains() unexpected error: %s", err)
+			}
+
+			if ok != tc.expected {
+				t.Errorf("JSONContains() = %t, want %t", ok, tc.expected)
+			}
+		})
+	}
+}